@@ -2,6 +2,7 @@ package minipool
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -87,7 +88,7 @@ func canChangeWithdrawalCreds(c *cli.Context, minipoolAddress common.Address, mn
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get the pubkey for minipool %s: %w", minipoolAddress.Hex(), err)
 	}
-	beaconStatus, err := bc.GetValidatorStatus(pubkey, nil)
+	beaconStatus, err := bc.GetValidatorStatus(context.Background(), pubkey, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error getting Beacon status for minipool %s (pubkey %s): %w", minipoolAddress.Hex(), pubkey.Hex(), err)
 	}
@@ -193,19 +194,19 @@ func changeWithdrawalCreds(c *cli.Context, minipoolAddress common.Address, mnemo
 	}
 
 	// Get beacon head
-	head, err := bc.GetBeaconHead()
+	head, err := bc.GetBeaconHead(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
 	// Get voluntary exit signature domain
-	signatureDomain, err := bc.GetDomainData(eth2types.DomainBlsToExecutionChange[:], head.Epoch, true)
+	signatureDomain, err := bc.GetDomainData(context.Background(), eth2types.DomainBlsToExecutionChange[:], head.Epoch, true)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get validator index
-	validatorIndex, err := bc.GetValidatorIndex(pubkey)
+	validatorIndex, err := bc.GetValidatorIndex(context.Background(), pubkey)
 	if err != nil {
 		return nil, err
 	}
@@ -218,7 +219,7 @@ func changeWithdrawalCreds(c *cli.Context, minipoolAddress common.Address, mnemo
 
 	// Broadcast withdrawal creds change message
 	withdrawalPubkey := types.BytesToValidatorPubkey(withdrawalKey.PublicKey().Marshal())
-	if err := bc.ChangeWithdrawalCredentials(validatorIndex, withdrawalPubkey, minipoolAddress, signature); err != nil {
+	if err := bc.ChangeWithdrawalCredentials(context.Background(), validatorIndex, withdrawalPubkey, minipoolAddress, signature); err != nil {
 		return nil, err
 	}
 
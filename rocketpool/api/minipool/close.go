@@ -114,7 +114,7 @@ func getMinipoolCloseDetailsForNode(c *cli.Context) (*api.GetMinipoolCloseDetail
 		pubkeyMap[mp.Address] = pubkey
 		pubkeys = append(pubkeys, pubkey)
 	}
-	statusMap, err := bc.GetValidatorStatuses(pubkeys, nil)
+	statusMap, err := bc.GetValidatorStatuses(context.Background(), pubkeys, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error getting beacon status of minipools: %w", err)
 	}
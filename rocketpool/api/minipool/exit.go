@@ -1,6 +1,8 @@
 package minipool
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/minipool"
 	"github.com/rocket-pool/rocketpool-go/types"
@@ -96,19 +98,19 @@ func exitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.ExitMini
 	}
 
 	// Get beacon head
-	head, err := bc.GetBeaconHead()
+	head, err := bc.GetBeaconHead(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
 	// Get voluntary exit signature domain
-	signatureDomain, err := bc.GetDomainData(eth2types.DomainVoluntaryExit[:], head.Epoch, false)
+	signatureDomain, err := bc.GetDomainData(context.Background(), eth2types.DomainVoluntaryExit[:], head.Epoch, false)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get validator index
-	validatorIndex, err := bc.GetValidatorIndex(validatorPubkey)
+	validatorIndex, err := bc.GetValidatorIndex(context.Background(), validatorPubkey)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +122,7 @@ func exitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.ExitMini
 	}
 
 	// Broadcast voluntary exit message
-	if err := bc.ExitValidator(validatorIndex, head.Epoch, signature); err != nil {
+	if err := bc.ExitValidator(context.Background(), validatorIndex, head.Epoch, signature); err != nil {
 		return nil, err
 	}
 
@@ -1,6 +1,7 @@
 package minipool
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
@@ -68,7 +69,7 @@ func canBeginReduceBondAmount(c *cli.Context, minipoolAddress common.Address, ne
 		if err != nil {
 			return fmt.Errorf("error retrieving pubkey for minipool %s: %w", minipoolAddress.Hex(), err)
 		}
-		status, err := bc.GetValidatorStatus(pubkey, nil)
+		status, err := bc.GetValidatorStatus(context.Background(), pubkey, nil)
 		if err != nil {
 			return fmt.Errorf("error getting validator status for minipool %s (pubkey %s): %w", minipoolAddress.Hex(), pubkey.Hex(), err)
 		}
@@ -1,6 +1,7 @@
 package minipool
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
@@ -160,7 +161,7 @@ func getMinipoolRescueDissolvedDetails(rp *rocketpool.RocketPool, w *wallet.Wall
 	}
 
 	// Check the Beacon status
-	beaconStatus, err := bc.GetValidatorStatus(pubkey, nil)
+	beaconStatus, err := bc.GetValidatorStatus(context.Background(), pubkey, nil)
 	if err != nil {
 		return api.MinipoolRescueDissolvedDetails{}, fmt.Errorf("error getting validator status for minipool %s (pubkey %s): %w", minipoolAddress.Hex(), pubkey.Hex(), err)
 	}
@@ -239,7 +240,7 @@ func getDepositTx(rp *rocketpool.RocketPool, w *wallet.Wallet, bc beacon.Client,
 	}
 
 	// Get eth2 config
-	eth2Config, err := bc.GetEth2Config()
+	eth2Config, err := bc.GetEth2Config(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +87,7 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 
 	if response.CanStake {
 		// Get eth2 config
-		eth2Config, err := bc.GetEth2Config()
+		eth2Config, err := bc.GetEth2Config(context.Background())
 		if err != nil {
 			return nil, err
 		}
@@ -190,7 +190,7 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 	}
 
 	// Get eth2 config
-	eth2Config, err := bc.GetEth2Config()
+	eth2Config, err := bc.GetEth2Config(context.Background())
 	if err != nil {
 		return nil, err
 	}
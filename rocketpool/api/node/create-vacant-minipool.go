@@ -124,7 +124,7 @@ func canCreateVacantMinipool(c *cli.Context, amountWei *big.Int, minNodeFee floa
 	}
 
 	// Check if the pubkey is for an existing active_ongoing validator
-	validatorStatus, err := bc.GetValidatorStatus(pubkey, nil)
+	validatorStatus, err := bc.GetValidatorStatus(context.Background(), pubkey, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error checking status of existing validator: %w", err)
 	}
@@ -238,7 +238,7 @@ func createVacantMinipool(c *cli.Context, amountWei *big.Int, minNodeFee float64
 	response.WithdrawalCredentials = withdrawalCredentials
 
 	// Check if the pubkey is for an existing active_ongoing validator
-	validatorStatus, err := bc.GetValidatorStatus(pubkey, nil)
+	validatorStatus, err := bc.GetValidatorStatus(context.Background(), pubkey, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error checking status of existing validator: %w", err)
 	}
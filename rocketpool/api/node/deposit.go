@@ -60,7 +60,7 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 	}
 
 	// Get eth2 config
-	eth2Config, err := bc.GetEth2Config()
+	eth2Config, err := bc.GetEth2Config(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -281,7 +281,7 @@ func nodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt *b
 	}
 
 	// Get eth2 config
-	eth2Config, err := bc.GetEth2Config()
+	eth2Config, err := bc.GetEth2Config(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -375,7 +375,7 @@ func nodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt *b
 	signature := rptypes.BytesToValidatorSignature(depositData.Signature)
 
 	// Make sure a validator with this pubkey doesn't already exist
-	status, err := bc.GetValidatorStatus(pubKey, nil)
+	status, err := bc.GetValidatorStatus(context.Background(), pubKey, nil)
 	if err != nil {
 		return nil, fmt.Errorf("Error checking for existing validator status: %w\nYour funds have not been deposited for your own safety.", err)
 	}
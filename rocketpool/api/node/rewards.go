@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/big"
@@ -264,7 +265,7 @@ func getRewards(c *cli.Context) (*api.NodeRewardsResponse, error) {
 
 	// Get the beacon head
 	wg.Go(func() error {
-		_beaconHead, err := bc.GetBeaconHead()
+		_beaconHead, err := bc.GetBeaconHead(context.Background())
 		if err != nil {
 			return fmt.Errorf("Error getting beacon chain head: %w", err)
 		}
@@ -349,7 +349,7 @@ func getTrueBorrowAndBondAmounts(rp *rocketpool.RocketPool, bc beacon.Client, no
 		return nil, nil, nil, nil, fmt.Errorf("error loading minipool details: %w", err)
 	}
 
-	beaconHead, err := bc.GetBeaconHead()
+	beaconHead, err := bc.GetBeaconHead(context.Background())
 	if err != nil {
 		return nil, nil, nil, nil, fmt.Errorf("error getting beacon head: %w", err)
 	}
@@ -463,7 +463,7 @@ func getTrueBorrowAndBondAmounts(rp *rocketpool.RocketPool, bc beacon.Client, no
 		return nil, nil, nil, nil, err
 	}
 
-	statuses, err := bc.GetValidatorStatuses(pubkeys, nil)
+	statuses, err := bc.GetValidatorStatuses(context.Background(), pubkeys, nil)
 	if err != nil {
 		return nil, nil, nil, nil, fmt.Errorf("error loading validator statuses: %w", err)
 	}
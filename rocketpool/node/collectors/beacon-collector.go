@@ -1,6 +1,7 @@
 package collectors
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -97,7 +98,7 @@ func (collector *BeaconCollector) Collect(channel chan<- prometheus.Metric) {
 		}
 	}
 
-	head, err := collector.bc.GetBeaconHead()
+	head, err := collector.bc.GetBeaconHead(context.Background())
 	if err != nil {
 		collector.logError(fmt.Errorf("error getting Beacon chain head: %w", err))
 		return
@@ -105,7 +106,7 @@ func (collector *BeaconCollector) Collect(channel chan<- prometheus.Metric) {
 
 	wg.Go(func() error {
 		// Get current duties
-		duties, err := collector.bc.GetValidatorSyncDuties(validatorIndices, head.Epoch)
+		duties, err := collector.bc.GetValidatorSyncDuties(context.Background(), validatorIndices, head.Epoch)
 		if err != nil {
 			return fmt.Errorf("Error getting sync duties: %w", err)
 		}
@@ -124,7 +125,7 @@ func (collector *BeaconCollector) Collect(channel chan<- prometheus.Metric) {
 		config := state.BeaconConfig
 
 		// Get upcoming duties
-		duties, err := collector.bc.GetValidatorSyncDuties(validatorIndices, head.Epoch+config.EpochsPerSyncCommitteePeriod)
+		duties, err := collector.bc.GetValidatorSyncDuties(context.Background(), validatorIndices, head.Epoch+config.EpochsPerSyncCommitteePeriod)
 		if err != nil {
 			return fmt.Errorf("Error getting sync duties: %w", err)
 		}
@@ -140,7 +141,7 @@ func (collector *BeaconCollector) Collect(channel chan<- prometheus.Metric) {
 
 	wg.Go(func() error {
 		// Get proposals in this epoch
-		duties, err := collector.bc.GetValidatorProposerDuties(validatorIndices, head.Epoch)
+		duties, err := collector.bc.GetValidatorProposerDuties(context.Background(), validatorIndices, head.Epoch)
 		if err != nil {
 			return fmt.Errorf("Error getting proposer duties: %w", err)
 		}
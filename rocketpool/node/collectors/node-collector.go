@@ -353,7 +353,7 @@ func (collector *NodeCollector) Collect(channel chan<- prometheus.Metric) {
 
 	// Get the beacon head
 	wg.Go(func() error {
-		_beaconHead, err := collector.bc.GetBeaconHead()
+		_beaconHead, err := collector.bc.GetBeaconHead(context.Background())
 		if err != nil {
 			return fmt.Errorf("Error getting beacon chain head: %w", err)
 		}
@@ -2,6 +2,7 @@ package watchtower
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"math/big"
 	"os"
@@ -103,7 +104,7 @@ func newProcessPenalties(c *cli.Context, logger log.ColorLogger, errorLogger log
 	}
 
 	// Get the Beacon config
-	beaconConfig, err := bc.GetEth2Config()
+	beaconConfig, err := bc.GetEth2Config(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +231,7 @@ func (t *processPenalties) run() error {
 		smoothingPoolAddress := *smoothingPoolContract.Address
 
 		// Get latest block
-		head, headExists, err := t.bc.GetBeaconBlock("finalized")
+		head, headExists, err := t.bc.GetBeaconBlock(context.Background(), "finalized")
 		if err != nil {
 			t.handleError(fmt.Errorf("%s Error getting beacon block: %w", checkPrefix, err))
 			return
@@ -269,7 +270,7 @@ func (t *processPenalties) run() error {
 		// Loop over unprocessed slots
 		slotsSinceUpdate := 0
 		for i := s.LatestPenaltySlot; i < currentSlot; i++ {
-			block, exists, err := t.bc.GetBeaconBlock(strconv.FormatUint(i, 10))
+			block, exists, err := t.bc.GetBeaconBlock(context.Background(), strconv.FormatUint(i, 10))
 			if err != nil {
 				t.handleError(fmt.Errorf("%s Error getting beacon block: %w", checkPrefix, err))
 				return
@@ -347,7 +348,7 @@ func (t *processPenalties) processBlock(block *beacon.BeaconBlock, smoothingPool
 		return isIllegalFeeRecipient, nil
 	}
 
-	status, err := t.bc.GetValidatorStatusByIndex(block.ProposerIndex, nil)
+	status, err := t.bc.GetValidatorStatusByIndex(context.Background(), block.ProposerIndex, nil)
 	if err != nil {
 		return isIllegalFeeRecipient, err
 	}
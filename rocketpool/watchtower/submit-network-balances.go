@@ -157,7 +157,7 @@ func (t *submitNetworkBalances) run(state *state.NetworkState) error {
 	requiredEpoch := slotNumber / eth2Config.SlotsPerEpoch
 
 	// Check if the required epoch is finalized yet
-	beaconHead, err := t.bc.GetBeaconHead()
+	beaconHead, err := t.bc.GetBeaconHead(context.Background())
 	if err != nil {
 		return err
 	}
@@ -79,7 +79,7 @@ func newSubmitRewardsTree_Rolling(c *cli.Context, logger log.ColorLogger, errorL
 	}
 
 	// Get the beacon config
-	beaconCfg, err := bc.GetEth2Config()
+	beaconCfg, err := bc.GetEth2Config(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("error getting beacon config: %w", err)
 	}
@@ -138,7 +138,7 @@ func newSubmitRewardsTree_Rolling(c *cli.Context, logger log.ColorLogger, errorL
 	}
 
 	// Load the latest checkpoint
-	beaconHead, err := bc.GetBeaconHead()
+	beaconHead, err := bc.GetBeaconHead(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("error getting beacon head: %w", err)
 	}
@@ -359,7 +359,7 @@ func (t *submitRewardsTree_Rolling) getTrueRewardsIntervalSubmissionSlot(targetS
 	// Get the first successful block
 	for {
 		// Try to get the current block
-		block, exists, err := t.bc.GetBeaconBlock(fmt.Sprint(targetSlot))
+		block, exists, err := t.bc.GetBeaconBlock(context.Background(), fmt.Sprint(targetSlot))
 		if err != nil {
 			return 0, 0, fmt.Errorf("error getting Beacon block %d: %w", targetSlot, err)
 		}
@@ -542,7 +542,7 @@ func (t *submitRewardsTree_Stateless) uploadFileToWeb3Storage(wrapperBytes []byt
 func (t *submitRewardsTree_Stateless) getSnapshotConsensusBlock(endTime time.Time, state *state.NetworkState) (uint64, uint64, error) {
 
 	// Get the beacon head
-	beaconHead, err := t.bc.GetBeaconHead()
+	beaconHead, err := t.bc.GetBeaconHead(context.Background())
 	if err != nil {
 		return 0, 0, fmt.Errorf("Error getting Beacon head: %w", err)
 	}
@@ -564,7 +564,7 @@ func (t *submitRewardsTree_Stateless) getSnapshotConsensusBlock(endTime time.Tim
 	// Get the first successful block
 	for {
 		// Try to get the current block
-		block, exists, err := t.bc.GetBeaconBlock(fmt.Sprint(targetSlot))
+		block, exists, err := t.bc.GetBeaconBlock(context.Background(), fmt.Sprint(targetSlot))
 		if err != nil {
 			return 0, 0, fmt.Errorf("Error getting Beacon block %d: %w", targetSlot, err)
 		}
@@ -319,7 +319,7 @@ func (t *submitRplPrice) run(state *state.NetworkState) error {
 
 	// Check if the targetEpoch is finalized yet
 	targetEpoch := slotNumber / eth2Config.SlotsPerEpoch
-	beaconHead, err := t.bc.GetBeaconHead()
+	beaconHead, err := t.bc.GetBeaconHead(context.Background())
 	if err != nil {
 		return err
 	}
@@ -1,8 +1,11 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/fatih/color"
@@ -15,14 +18,22 @@ import (
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
+// How often the preferred client's sync status is re-checked, and how often a primary client that was failed away from is checked for recovery
+const bcStatusCheckInterval = time.Minute
+
 // This is a proxy for multiple Beacon clients, providing natural fallback support if one of them fails.
+// Clients are tried in order, starting with the last one that served a request successfully.
 type BeaconClientManager struct {
-	primaryBc       beacon.Client
-	fallbackBc      beacon.Client
+	clients         []beacon.Client
+	endpoints       []string
+	ready           []bool
+	checked         []time.Time // When each client's sync status was last checked
+	preferred       int
+	lastEndpoint    string
 	logger          log.ColorLogger
-	primaryReady    bool
-	fallbackReady   bool
 	ignoreSyncCheck bool
+	fallbacksForced bool
+	lock            sync.Mutex
 }
 
 // This is a signature for a wrapped Beacon client function that only returns an error
@@ -72,21 +83,50 @@ func NewBeaconClientManager(cfg *config.RocketPoolConfig) (*BeaconClientManager,
 		}
 	}
 
-	var primaryBc beacon.Client
-	var fallbackBc beacon.Client
-	primaryBc = client.NewStandardHttpClient(primaryProvider)
+	endpoints := []string{primaryProvider}
 	if fallbackProvider != "" {
-		fallbackBc = client.NewStandardHttpClient(fallbackProvider)
+		endpoints = append(endpoints, fallbackProvider)
+	}
+	return NewBeaconClientManagerForEndpoints(endpoints)
+
+}
+
+// Creates a new BeaconClientManager instance for an ordered list of Beacon node endpoints.
+// The first endpoint is the primary; the rest are fallbacks that are used, in order, if the ones before them fail.
+func NewBeaconClientManagerForEndpoints(endpoints []string, opts ...client.StandardHttpClientOption) (*BeaconClientManager, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("at least one Beacon node endpoint is required")
+	}
+
+	clients := make([]beacon.Client, len(endpoints))
+	ready := make([]bool, len(endpoints))
+	for i, endpoint := range endpoints {
+		clients[i] = client.NewStandardHttpClient(endpoint, opts...)
+		ready[i] = true
 	}
 
 	return &BeaconClientManager{
-		primaryBc:     primaryBc,
-		fallbackBc:    fallbackBc,
-		logger:        log.NewColorLogger(color.FgHiBlue),
-		primaryReady:  true,
-		fallbackReady: fallbackBc != nil,
+		clients:   clients,
+		endpoints: endpoints,
+		ready:     ready,
+		checked:   make([]time.Time, len(endpoints)),
+		logger:    log.NewColorLogger(color.FgHiBlue),
 	}, nil
+}
+
+// Get the endpoint of the Beacon node that served the most recent request, for diagnostics
+func (m *BeaconClientManager) LastEndpoint() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.lastEndpoint
+}
 
+// Mark the primary client as unavailable so requests go to the fallbacks
+func (m *BeaconClientManager) forceFallbacks() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.ready[0] = false
+	m.fallbacksForced = true
 }
 
 /// ======================
@@ -105,9 +145,9 @@ func (m *BeaconClientManager) GetClientType() (beacon.BeaconClientType, error) {
 }
 
 // Get the client's sync status
-func (m *BeaconClientManager) GetSyncStatus() (beacon.SyncStatus, error) {
+func (m *BeaconClientManager) GetSyncStatus(ctx context.Context) (beacon.SyncStatus, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetSyncStatus()
+		return client.GetSyncStatus(ctx)
 	})
 	if err != nil {
 		return beacon.SyncStatus{}, err
@@ -115,10 +155,50 @@ func (m *BeaconClientManager) GetSyncStatus() (beacon.SyncStatus, error) {
 	return result.(beacon.SyncStatus), nil
 }
 
+// Get the slot of the client's head block
+func (m *BeaconClientManager) GetHeadSlot(ctx context.Context) (uint64, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetHeadSlot(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return result.(uint64), nil
+}
+
+// Get the client's health
+func (m *BeaconClientManager) GetHealth(ctx context.Context, syncingStatus int) (beacon.HealthStatus, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetHealth(ctx, syncingStatus)
+	})
+	if err != nil {
+		return beacon.HealthStatus_Unhealthy, err
+	}
+	return result.(beacon.HealthStatus), nil
+}
+
+// Get the node's version and implementation
+func (m *BeaconClientManager) GetNodeVersion(ctx context.Context) (beacon.NodeVersion, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetNodeVersion(ctx)
+	})
+	if err != nil {
+		return beacon.NodeVersion{}, err
+	}
+	return result.(beacon.NodeVersion), nil
+}
+
+// Clear the cached Beacon configuration and genesis on every client
+func (m *BeaconClientManager) RefreshConfig() {
+	for _, client := range m.clients {
+		client.RefreshConfig()
+	}
+}
+
 // Get the Beacon configuration
-func (m *BeaconClientManager) GetEth2Config() (beacon.Eth2Config, error) {
+func (m *BeaconClientManager) GetEth2Config(ctx context.Context) (beacon.Eth2Config, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetEth2Config()
+		return client.GetEth2Config(ctx)
 	})
 	if err != nil {
 		return beacon.Eth2Config{}, err
@@ -127,9 +207,9 @@ func (m *BeaconClientManager) GetEth2Config() (beacon.Eth2Config, error) {
 }
 
 // Get the Beacon configuration
-func (m *BeaconClientManager) GetEth2DepositContract() (beacon.Eth2DepositContract, error) {
+func (m *BeaconClientManager) GetEth2DepositContract(ctx context.Context) (beacon.Eth2DepositContract, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetEth2DepositContract()
+		return client.GetEth2DepositContract(ctx)
 	})
 	if err != nil {
 		return beacon.Eth2DepositContract{}, err
@@ -137,10 +217,32 @@ func (m *BeaconClientManager) GetEth2DepositContract() (beacon.Eth2DepositContra
 	return result.(beacon.Eth2DepositContract), nil
 }
 
+// Get the Beacon chain's fork schedule
+func (m *BeaconClientManager) GetForkSchedule(ctx context.Context) ([]beacon.Fork, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetForkSchedule(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.Fork), nil
+}
+
+// Get the Beacon chain's finalized deposit tree snapshot
+func (m *BeaconClientManager) GetDepositSnapshot(ctx context.Context) (beacon.DepositSnapshot, bool, error) {
+	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return client.GetDepositSnapshot(ctx)
+	})
+	if err != nil {
+		return beacon.DepositSnapshot{}, false, err
+	}
+	return result1.(beacon.DepositSnapshot), result2.(bool), nil
+}
+
 // Get the attestations in a Beacon chain block
-func (m *BeaconClientManager) GetAttestations(blockId string) ([]beacon.AttestationInfo, bool, error) {
+func (m *BeaconClientManager) GetAttestations(ctx context.Context, blockId string) ([]beacon.AttestationInfo, bool, error) {
 	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
-		return client.GetAttestations(blockId)
+		return client.GetAttestations(ctx, blockId)
 	})
 	if err != nil {
 		return nil, false, err
@@ -148,10 +250,162 @@ func (m *BeaconClientManager) GetAttestations(blockId string) ([]beacon.Attestat
 	return result1.([]beacon.AttestationInfo), result2.(bool), nil
 }
 
+// Produce an unsigned block for a slot
+func (m *BeaconClientManager) ProduceBlock(ctx context.Context, slot uint64, randaoReveal types.ValidatorSignature, graffiti []byte) (beacon.ProducedBlock, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.ProduceBlock(ctx, slot, randaoReveal, graffiti)
+	})
+	if err != nil {
+		return beacon.ProducedBlock{}, err
+	}
+	return result.(beacon.ProducedBlock), nil
+}
+
+// Produce an unsigned blinded block for a slot
+func (m *BeaconClientManager) ProduceBlindedBlock(ctx context.Context, slot uint64, randaoReveal types.ValidatorSignature, graffiti []byte) (beacon.ProducedBlindedBlock, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.ProduceBlindedBlock(ctx, slot, randaoReveal, graffiti)
+	})
+	if err != nil {
+		return beacon.ProducedBlindedBlock{}, err
+	}
+	return result.(beacon.ProducedBlindedBlock), nil
+}
+
+// Check if the Beacon node supports producing blinded blocks
+func (m *BeaconClientManager) SupportsBlindedBlocks(ctx context.Context) (bool, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.SupportsBlindedBlocks(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	return result.(bool), nil
+}
+
+// Get the fee recipient the Beacon node has configured for a validator
+func (m *BeaconClientManager) GetFeeRecipient(ctx context.Context, pubkey types.ValidatorPubkey) (common.Address, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetFeeRecipient(ctx, pubkey)
+	})
+	if err != nil {
+		return common.Address{}, err
+	}
+	return result.(common.Address), nil
+}
+
+// Register the fee recipients the Beacon node should use for proposals
+func (m *BeaconClientManager) PrepareBeaconProposer(ctx context.Context, proposers []beacon.ProposerPreparation) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.PrepareBeaconProposer(ctx, proposers)
+	})
+	return err
+}
+
+// Submit builder registrations for validators
+func (m *BeaconClientManager) RegisterValidators(ctx context.Context, registrations []beacon.SignedValidatorRegistration) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.RegisterValidators(ctx, registrations)
+	})
+	return err
+}
+
+// Submit signed attestations to the Beacon node's operation pool
+func (m *BeaconClientManager) SubmitAttestations(ctx context.Context, attestations []beacon.Attestation) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.SubmitAttestations(ctx, attestations)
+	})
+	return err
+}
+
+// Submit signed sync committee messages to the Beacon node's operation pool
+func (m *BeaconClientManager) SubmitSyncCommitteeMessages(ctx context.Context, messages []beacon.SyncCommitteeMessage) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.SubmitSyncCommitteeMessages(ctx, messages)
+	})
+	return err
+}
+
+// Subscribe the Beacon node to the sync committee subnets of validators
+func (m *BeaconClientManager) SubscribeToSyncCommitteeSubnets(ctx context.Context, subscriptions []beacon.SyncCommitteeSubscription) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.SubscribeToSyncCommitteeSubnets(ctx, subscriptions)
+	})
+	return err
+}
+
+// Subscribe the Beacon node to the attestation subnets of validators' beacon committees
+func (m *BeaconClientManager) SubscribeToBeaconCommitteeSubnets(ctx context.Context, subscriptions []beacon.BeaconCommitteeSubscription) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.SubscribeToBeaconCommitteeSubnets(ctx, subscriptions)
+	})
+	return err
+}
+
+// Wait until the Beacon node's head reaches a slot
+func (m *BeaconClientManager) WaitForSlot(ctx context.Context, slot uint64, pollInterval time.Duration) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.WaitForSlot(ctx, slot, pollInterval)
+	})
+	return err
+}
+
+// Wait until the Beacon node's head reaches the first slot of an epoch
+func (m *BeaconClientManager) WaitForEpoch(ctx context.Context, epoch uint64, pollInterval time.Duration) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.WaitForEpoch(ctx, epoch, pollInterval)
+	})
+	return err
+}
+
+// Check if the Beacon node keeps historical states
+func (m *BeaconClientManager) SupportsHistoricalStates(ctx context.Context) (bool, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.SupportsHistoricalStates(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	return result.(bool), nil
+}
+
+// Get the earliest slot the Beacon node has the state for
+func (m *BeaconClientManager) GetEarliestAvailableSlot(ctx context.Context) (uint64, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetEarliestAvailableSlot(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return result.(uint64), nil
+}
+
+// Get the attestation data for a slot and committee
+func (m *BeaconClientManager) GetAttestationData(ctx context.Context, slot uint64, committeeIndex uint64) (beacon.AttestationData, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetAttestationData(ctx, slot, committeeIndex)
+	})
+	if err != nil {
+		return beacon.AttestationData{}, err
+	}
+	return result.(beacon.AttestationData), nil
+}
+
+// Get the aggregate attestation for an attestation data root and slot
+func (m *BeaconClientManager) GetAggregateAttestation(ctx context.Context, attestationDataRoot common.Hash, slot uint64) (beacon.Attestation, bool, error) {
+	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return client.GetAggregateAttestation(ctx, attestationDataRoot, slot)
+	})
+	if err != nil {
+		return beacon.Attestation{}, false, err
+	}
+	return result1.(beacon.Attestation), result2.(bool), nil
+}
+
 // Get a Beacon chain block
-func (m *BeaconClientManager) GetBeaconBlock(blockId string) (beacon.BeaconBlock, bool, error) {
+func (m *BeaconClientManager) GetBeaconBlock(ctx context.Context, blockId string) (beacon.BeaconBlock, bool, error) {
 	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
-		return client.GetBeaconBlock(blockId)
+		return client.GetBeaconBlock(ctx, blockId)
 	})
 	if err != nil {
 		return beacon.BeaconBlock{}, false, err
@@ -160,9 +414,9 @@ func (m *BeaconClientManager) GetBeaconBlock(blockId string) (beacon.BeaconBlock
 }
 
 // Get the Beacon chain's head information
-func (m *BeaconClientManager) GetBeaconHead() (beacon.BeaconHead, error) {
+func (m *BeaconClientManager) GetBeaconHead(ctx context.Context) (beacon.BeaconHead, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetBeaconHead()
+		return client.GetBeaconHead(ctx)
 	})
 	if err != nil {
 		return beacon.BeaconHead{}, err
@@ -170,10 +424,43 @@ func (m *BeaconClientManager) GetBeaconHead() (beacon.BeaconHead, error) {
 	return result.(beacon.BeaconHead), nil
 }
 
+// Get the latest finalized checkpoint, and whether it has advanced since it was last checked
+func (m *BeaconClientManager) CheckFinalizedCheckpoint(ctx context.Context) (beacon.FinalizedCheckpointUpdate, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.CheckFinalizedCheckpoint(ctx)
+	})
+	if err != nil {
+		return beacon.FinalizedCheckpointUpdate{}, err
+	}
+	return result.(beacon.FinalizedCheckpointUpdate), nil
+}
+
+// Estimate how many epochs from now a validator will be activated
+func (m *BeaconClientManager) EstimateActivationTime(ctx context.Context, activationEligibilityEpoch uint64) (uint64, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.EstimateActivationTime(ctx, activationEligibilityEpoch)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return result.(uint64), nil
+}
+
+// Estimate when a validator that exits now would exit and become withdrawable
+func (m *BeaconClientManager) EstimateExitTime(ctx context.Context) (beacon.ExitTimeEstimate, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.EstimateExitTime(ctx)
+	})
+	if err != nil {
+		return beacon.ExitTimeEstimate{}, err
+	}
+	return result.(beacon.ExitTimeEstimate), nil
+}
+
 // Get a validator's status by its index
-func (m *BeaconClientManager) GetValidatorStatusByIndex(index string, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
+func (m *BeaconClientManager) GetValidatorStatusByIndex(ctx context.Context, index string, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetValidatorStatusByIndex(index, opts)
+		return client.GetValidatorStatusByIndex(ctx, index, opts)
 	})
 	if err != nil {
 		return beacon.ValidatorStatus{}, err
@@ -182,9 +469,20 @@ func (m *BeaconClientManager) GetValidatorStatusByIndex(index string, opts *beac
 }
 
 // Get a validator's status by its pubkey
-func (m *BeaconClientManager) GetValidatorStatus(pubkey types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
+func (m *BeaconClientManager) GetValidatorStatus(ctx context.Context, pubkey types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetValidatorStatus(ctx, pubkey, opts)
+	})
+	if err != nil {
+		return beacon.ValidatorStatus{}, err
+	}
+	return result.(beacon.ValidatorStatus), nil
+}
+
+// Get a single validator by pubkey or index
+func (m *BeaconClientManager) GetValidator(ctx context.Context, stateId string, validatorId string) (beacon.ValidatorStatus, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetValidatorStatus(pubkey, opts)
+		return client.GetValidator(ctx, stateId, validatorId)
 	})
 	if err != nil {
 		return beacon.ValidatorStatus{}, err
@@ -192,10 +490,32 @@ func (m *BeaconClientManager) GetValidatorStatus(pubkey types.ValidatorPubkey, o
 	return result.(beacon.ValidatorStatus), nil
 }
 
+// Get all of the validators in a state, fetched in chunks
+func (m *BeaconClientManager) GetValidatorsChunked(ctx context.Context, stateId string, chunkSize int, progress func(done int, total int)) ([]beacon.ValidatorStatus, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetValidatorsChunked(ctx, stateId, chunkSize, progress)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.ValidatorStatus), nil
+}
+
+// Get all of the validators in a state with one of the provided statuses
+func (m *BeaconClientManager) GetValidatorsByStatus(ctx context.Context, stateId string, statuses []beacon.ValidatorState) ([]beacon.ValidatorStatus, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetValidatorsByStatus(ctx, stateId, statuses)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.ValidatorStatus), nil
+}
+
 // Get the statuses of multiple validators by their pubkeys
-func (m *BeaconClientManager) GetValidatorStatuses(pubkeys []types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (map[types.ValidatorPubkey]beacon.ValidatorStatus, error) {
+func (m *BeaconClientManager) GetValidatorStatuses(ctx context.Context, pubkeys []types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (map[types.ValidatorPubkey]beacon.ValidatorStatus, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetValidatorStatuses(pubkeys, opts)
+		return client.GetValidatorStatuses(ctx, pubkeys, opts)
 	})
 	if err != nil {
 		return nil, err
@@ -204,9 +524,9 @@ func (m *BeaconClientManager) GetValidatorStatuses(pubkeys []types.ValidatorPubk
 }
 
 // Get a validator's index
-func (m *BeaconClientManager) GetValidatorIndex(pubkey types.ValidatorPubkey) (string, error) {
+func (m *BeaconClientManager) GetValidatorIndex(ctx context.Context, pubkey types.ValidatorPubkey) (string, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetValidatorIndex(pubkey)
+		return client.GetValidatorIndex(ctx, pubkey)
 	})
 	if err != nil {
 		return "", err
@@ -214,10 +534,21 @@ func (m *BeaconClientManager) GetValidatorIndex(pubkey types.ValidatorPubkey) (s
 	return result.(string), nil
 }
 
+// Get the indices of multiple validators
+func (m *BeaconClientManager) GetValidatorIndices(ctx context.Context, pubkeys []types.ValidatorPubkey) (map[string]string, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetValidatorIndices(ctx, pubkeys)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(map[string]string), nil
+}
+
 // Get a validator's sync duties
-func (m *BeaconClientManager) GetValidatorSyncDuties(indices []string, epoch uint64) (map[string]bool, error) {
+func (m *BeaconClientManager) GetValidatorSyncDuties(ctx context.Context, indices []string, epoch uint64) (map[string]bool, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetValidatorSyncDuties(indices, epoch)
+		return client.GetValidatorSyncDuties(ctx, indices, epoch)
 	})
 	if err != nil {
 		return nil, err
@@ -226,9 +557,9 @@ func (m *BeaconClientManager) GetValidatorSyncDuties(indices []string, epoch uin
 }
 
 // Get a validator's proposer duties
-func (m *BeaconClientManager) GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error) {
+func (m *BeaconClientManager) GetValidatorProposerDuties(ctx context.Context, indices []string, epoch uint64) (map[string]uint64, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetValidatorProposerDuties(indices, epoch)
+		return client.GetValidatorProposerDuties(ctx, indices, epoch)
 	})
 	if err != nil {
 		return nil, err
@@ -236,10 +567,32 @@ func (m *BeaconClientManager) GetValidatorProposerDuties(indices []string, epoch
 	return result.(map[string]uint64), nil
 }
 
+// Get all of the proposer duties for an epoch
+func (m *BeaconClientManager) GetProposerDuties(ctx context.Context, epoch uint64) (beacon.ProposerDuties, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetProposerDuties(ctx, epoch)
+	})
+	if err != nil {
+		return beacon.ProposerDuties{}, err
+	}
+	return result.(beacon.ProposerDuties), nil
+}
+
+// Get the attester duties for the given validators during an epoch
+func (m *BeaconClientManager) GetAttesterDuties(ctx context.Context, epoch uint64, indices []string) (beacon.AttesterDuties, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetAttesterDuties(ctx, epoch, indices)
+	})
+	if err != nil {
+		return beacon.AttesterDuties{}, err
+	}
+	return result.(beacon.AttesterDuties), nil
+}
+
 // Get the Beacon chain's domain data
-func (m *BeaconClientManager) GetDomainData(domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error) {
+func (m *BeaconClientManager) GetDomainData(ctx context.Context, domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetDomainData(domainType, epoch, useGenesisFork)
+		return client.GetDomainData(ctx, domainType, epoch, useGenesisFork)
 	})
 	if err != nil {
 		return nil, err
@@ -248,9 +601,9 @@ func (m *BeaconClientManager) GetDomainData(domainType []byte, epoch uint64, use
 }
 
 // Voluntarily exit a validator
-func (m *BeaconClientManager) ExitValidator(validatorIndex string, epoch uint64, signature types.ValidatorSignature) error {
+func (m *BeaconClientManager) ExitValidator(ctx context.Context, validatorIndex string, epoch uint64, signature types.ValidatorSignature) error {
 	err := m.runFunction0(func(client beacon.Client) error {
-		return client.ExitValidator(validatorIndex, epoch, signature)
+		return client.ExitValidator(ctx, validatorIndex, epoch, signature)
 	})
 	return err
 }
@@ -264,9 +617,9 @@ func (m *BeaconClientManager) Close() error {
 }
 
 // Get the EL data for a CL block
-func (m *BeaconClientManager) GetEth1DataForEth2Block(blockId string) (beacon.Eth1Data, bool, error) {
+func (m *BeaconClientManager) GetEth1DataForEth2Block(ctx context.Context, blockId string) (beacon.Eth1Data, bool, error) {
 	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
-		return client.GetEth1DataForEth2Block(blockId)
+		return client.GetEth1DataForEth2Block(ctx, blockId)
 	})
 	if err != nil {
 		return beacon.Eth1Data{}, false, err
@@ -275,9 +628,20 @@ func (m *BeaconClientManager) GetEth1DataForEth2Block(blockId string) (beacon.Et
 }
 
 // Get the attestation committees for an epoch
-func (m *BeaconClientManager) GetCommitteesForEpoch(epoch *uint64) (beacon.Committees, error) {
+func (m *BeaconClientManager) GetCommitteesForEpoch(ctx context.Context, epoch *uint64) (beacon.Committees, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetCommitteesForEpoch(ctx, epoch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(beacon.Committees), nil
+}
+
+// Get the committees for a state, optionally filtered by epoch, committee index, and slot
+func (m *BeaconClientManager) GetCommittees(ctx context.Context, stateId string, epoch *uint64, index *uint64, slot *uint64) (beacon.Committees, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetCommitteesForEpoch(epoch)
+		return client.GetCommittees(ctx, stateId, epoch, index, slot)
 	})
 	if err != nil {
 		return nil, err
@@ -285,10 +649,100 @@ func (m *BeaconClientManager) GetCommitteesForEpoch(epoch *uint64) (beacon.Commi
 	return result.(beacon.Committees), nil
 }
 
+// Get the sync committee for a state, optionally for a specific epoch
+func (m *BeaconClientManager) GetSyncCommittees(ctx context.Context, stateId string, epoch *uint64) (beacon.SyncCommittee, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetSyncCommittees(ctx, stateId, epoch)
+	})
+	if err != nil {
+		return beacon.SyncCommittee{}, err
+	}
+	return result.(beacon.SyncCommittee), nil
+}
+
+// Subscribe to the Beacon node's event stream
+func (m *BeaconClientManager) Events(ctx context.Context, topics []string) (<-chan beacon.Event, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.Events(ctx, topics)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(<-chan beacon.Event), nil
+}
+
+// Submit an attester slashing to the Beacon node's pool
+func (m *BeaconClientManager) SubmitAttesterSlashing(ctx context.Context, slashing beacon.AttesterSlashing) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.SubmitAttesterSlashing(ctx, slashing)
+	})
+	return err
+}
+
+// Submit a proposer slashing to the Beacon node's pool
+func (m *BeaconClientManager) SubmitProposerSlashing(ctx context.Context, slashing beacon.ProposerSlashing) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.SubmitProposerSlashing(ctx, slashing)
+	})
+	return err
+}
+
+// Get the attester slashings in the Beacon node's pool
+func (m *BeaconClientManager) GetPoolAttesterSlashings(ctx context.Context) ([]beacon.AttesterSlashing, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetPoolAttesterSlashings(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.AttesterSlashing), nil
+}
+
+// Get the proposer slashings in the Beacon node's pool
+func (m *BeaconClientManager) GetPoolProposerSlashings(ctx context.Context) ([]beacon.ProposerSlashing, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetPoolProposerSlashings(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.ProposerSlashing), nil
+}
+
+// Change the withdrawal credentials for a batch of validators
+func (m *BeaconClientManager) SubmitBLSToExecutionChanges(ctx context.Context, changes []beacon.SignedBLSToExecutionChange) error {
+	err := m.runFunction0(func(client beacon.Client) error {
+		return client.SubmitBLSToExecutionChanges(ctx, changes)
+	})
+	return err
+}
+
+// Get the withdrawal credentials changes in the Beacon node's pool
+func (m *BeaconClientManager) GetPoolBLSToExecutionChanges(ctx context.Context) ([]beacon.SignedBLSToExecutionChange, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetPoolBLSToExecutionChanges(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.SignedBLSToExecutionChange), nil
+}
+
+// Get the voluntary exits in the Beacon node's pool
+func (m *BeaconClientManager) GetPoolVoluntaryExits(ctx context.Context) ([]beacon.SignedVoluntaryExit, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetPoolVoluntaryExits(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.SignedVoluntaryExit), nil
+}
+
 // Change the withdrawal credentials for a validator
-func (m *BeaconClientManager) ChangeWithdrawalCredentials(validatorIndex string, fromBlsPubkey types.ValidatorPubkey, toExecutionAddress common.Address, signature types.ValidatorSignature) error {
+func (m *BeaconClientManager) ChangeWithdrawalCredentials(ctx context.Context, validatorIndex string, fromBlsPubkey types.ValidatorPubkey, toExecutionAddress common.Address, signature types.ValidatorSignature) error {
 	err := m.runFunction0(func(client beacon.Client) error {
-		return client.ChangeWithdrawalCredentials(validatorIndex, fromBlsPubkey, toExecutionAddress, signature)
+		return client.ChangeWithdrawalCredentials(ctx, validatorIndex, fromBlsPubkey, toExecutionAddress, signature)
 	})
 	if err != nil {
 		return err
@@ -296,6 +750,182 @@ func (m *BeaconClientManager) ChangeWithdrawalCredentials(validatorIndex string,
 	return nil
 }
 
+// Get the blob sidecars for a Beacon chain block
+func (m *BeaconClientManager) GetBlobSidecars(ctx context.Context, blockId string, indices []uint64) ([]beacon.BlobSidecar, bool, error) {
+	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return client.GetBlobSidecars(ctx, blockId, indices)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return result1.([]beacon.BlobSidecar), result2.(bool), nil
+}
+
+// Get the balances of multiple validators by their pubkeys or indices
+func (m *BeaconClientManager) GetValidatorBalances(ctx context.Context, stateId string, pubkeysOrIndices []string) (map[string]uint64, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetValidatorBalances(ctx, stateId, pubkeysOrIndices)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(map[string]uint64), nil
+}
+
+// Get the withdrawals expected in the block after the provided state
+func (m *BeaconClientManager) GetExpectedWithdrawals(ctx context.Context, stateId string) ([]beacon.Withdrawal, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetExpectedWithdrawals(ctx, stateId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.Withdrawal), nil
+}
+
+// Get the consensus layer rewards for a Beacon chain block's proposer
+func (m *BeaconClientManager) GetBlockRewards(ctx context.Context, blockId string) (beacon.BlockRewards, bool, error) {
+	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return client.GetBlockRewards(ctx, blockId)
+	})
+	if err != nil {
+		return beacon.BlockRewards{}, false, err
+	}
+	return result1.(beacon.BlockRewards), result2.(bool), nil
+}
+
+// Get the attestation rewards for validators at an epoch
+func (m *BeaconClientManager) GetAttestationRewards(ctx context.Context, epoch uint64, indices []string) (beacon.AttestationRewards, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetAttestationRewards(ctx, epoch, indices)
+	})
+	if err != nil {
+		return beacon.AttestationRewards{}, err
+	}
+	return result.(beacon.AttestationRewards), nil
+}
+
+// Get the sync committee rewards for a Beacon chain block
+func (m *BeaconClientManager) GetSyncCommitteeRewards(ctx context.Context, blockId string, indices []string) (map[string]int64, bool, error) {
+	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return client.GetSyncCommitteeRewards(ctx, blockId, indices)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return result1.(map[string]int64), result2.(bool), nil
+}
+
+// Get the liveness of validators at an epoch
+func (m *BeaconClientManager) GetValidatorLiveness(ctx context.Context, epoch uint64, indices []string) (map[string]bool, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetValidatorLiveness(ctx, epoch, indices)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(map[string]bool), nil
+}
+
+// Get the Beacon node's network identity
+func (m *BeaconClientManager) GetNodeIdentity(ctx context.Context) (beacon.NodeIdentity, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetNodeIdentity(ctx)
+	})
+	if err != nil {
+		return beacon.NodeIdentity{}, err
+	}
+	return result.(beacon.NodeIdentity), nil
+}
+
+// Get the Beacon node's peer count
+func (m *BeaconClientManager) GetPeerCount(ctx context.Context) (beacon.PeerCount, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetPeerCount(ctx)
+	})
+	if err != nil {
+		return beacon.PeerCount{}, err
+	}
+	return result.(beacon.PeerCount), nil
+}
+
+// Get the Beacon node's peers
+func (m *BeaconClientManager) GetPeers(ctx context.Context, states []string, directions []string) ([]beacon.Peer, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetPeers(ctx, states, directions)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.Peer), nil
+}
+
+// Get the root of a state
+func (m *BeaconClientManager) GetStateRoot(ctx context.Context, stateId string) (common.Hash, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetStateRoot(ctx, stateId)
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return result.(common.Hash), nil
+}
+
+// Get the RANDAO mix for a state, optionally at a specific epoch
+func (m *BeaconClientManager) GetRandao(ctx context.Context, stateId string, epoch *uint64) (common.Hash, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetRandao(ctx, stateId, epoch)
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return result.(common.Hash), nil
+}
+
+// Get the header of a block
+func (m *BeaconClientManager) GetBlockHeader(ctx context.Context, blockId string) (beacon.BlockHeader, bool, error) {
+	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return client.GetBlockHeader(ctx, blockId)
+	})
+	if err != nil {
+		return beacon.BlockHeader{}, false, err
+	}
+	return result1.(beacon.BlockHeader), result2.(bool), nil
+}
+
+// Get the blocks at multiple slots, fetched in parallel
+func (m *BeaconClientManager) GetBeaconBlocks(ctx context.Context, slots []uint64, concurrency int) (map[uint64]*beacon.BeaconBlock, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetBeaconBlocks(ctx, slots, concurrency)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(map[uint64]*beacon.BeaconBlock), nil
+}
+
+// Get a block by its root, confirming that it's canonical
+func (m *BeaconClientManager) GetBeaconBlockByRoot(ctx context.Context, root common.Hash) (beacon.BeaconBlock, bool, error) {
+	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return client.GetBeaconBlockByRoot(ctx, root)
+	})
+	if err != nil {
+		return beacon.BeaconBlock{}, false, err
+	}
+	return result1.(beacon.BeaconBlock), result2.(bool), nil
+}
+
+// Get the headers of blocks matching the provided slot and/or parent root
+func (m *BeaconClientManager) GetBlockHeaders(ctx context.Context, slot *uint64, parentRoot *common.Hash) ([]beacon.BlockHeader, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetBlockHeaders(ctx, slot, parentRoot)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.BlockHeader), nil
+}
+
 /// ==================
 /// Internal Functions
 /// ==================
@@ -303,31 +933,43 @@ func (m *BeaconClientManager) ChangeWithdrawalCredentials(validatorIndex string,
 func (m *BeaconClientManager) CheckStatus() *api.ClientManagerStatus {
 
 	status := &api.ClientManagerStatus{
-		FallbackEnabled: m.fallbackBc != nil,
+		FallbackEnabled: len(m.clients) > 1,
 	}
 
 	// Ignore the sync check and just use the predefined settings if requested
 	if m.ignoreSyncCheck {
-		status.PrimaryClientStatus.IsWorking = m.primaryReady
-		status.PrimaryClientStatus.IsSynced = m.primaryReady
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		status.PrimaryClientStatus.IsWorking = m.ready[0]
+		status.PrimaryClientStatus.IsSynced = m.ready[0]
 		if status.FallbackEnabled {
-			status.FallbackClientStatus.IsWorking = m.fallbackReady
-			status.FallbackClientStatus.IsSynced = m.fallbackReady
+			status.FallbackClientStatus.IsWorking = m.ready[1]
+			status.FallbackClientStatus.IsSynced = m.ready[1]
 		}
 		return status
 	}
 
-	// Get the primary BC status
-	status.PrimaryClientStatus = checkBcStatus(m.primaryBc)
-
-	// Get the fallback BC status if applicable
+	// Get the status of each client
+	statuses := make([]api.ClientStatus, len(m.clients))
+	for i, bc := range m.clients {
+		statuses[i] = checkBcStatus(bc)
+	}
+	status.PrimaryClientStatus = statuses[0]
 	if status.FallbackEnabled {
-		status.FallbackClientStatus = checkBcStatus(m.fallbackBc)
+		status.FallbackClientStatus = statuses[1]
 	}
 
-	// Flag the ready clients
-	m.primaryReady = (status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced)
-	m.fallbackReady = (status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced)
+	// Flag the ready clients, and go back to the primary if it's ready again
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	now := time.Now()
+	for i, clientStatus := range statuses {
+		m.ready[i] = (clientStatus.IsWorking && clientStatus.IsSynced)
+		m.checked[i] = now
+	}
+	if m.ready[0] && !m.fallbacksForced {
+		m.preferred = 0
+	}
 
 	return status
 
@@ -338,8 +980,8 @@ func checkBcStatus(client beacon.Client) api.ClientStatus {
 
 	status := api.ClientStatus{}
 
-	// Get the fallback's sync progress
-	syncStatus, err := client.GetSyncStatus()
+	// Get the client's sync progress
+	syncStatus, err := client.GetSyncStatus(context.Background())
 	if err != nil {
 		status.Error = fmt.Sprintf("Sync progress check failed with [%s]", err.Error())
 		status.IsSynced = false
@@ -347,8 +989,17 @@ func checkBcStatus(client beacon.Client) api.ClientStatus {
 		return status
 	}
 
-	// Return the sync status
-	if !syncStatus.Syncing {
+	// A node with its execution client offline can't serve reliable data, even if it's synced
+	if syncStatus.ElOffline {
+		status.Error = "Beacon node reports that its execution client is offline"
+		status.IsSynced = false
+		status.IsWorking = false
+		status.SyncProgress = syncStatus.Progress
+		return status
+	}
+
+	// Return the sync status; an optimistic node hasn't verified its latest execution payloads yet, so it isn't considered synced
+	if !syncStatus.Syncing && !syncStatus.IsOptimistic {
 		status.IsWorking = true
 		status.IsSynced = true
 		status.SyncProgress = 1
@@ -363,128 +1014,137 @@ func checkBcStatus(client beacon.Client) api.ClientStatus {
 
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
-
-	// Check if we can use the primary
-	if m.primaryReady {
-		// Try to run the function on the primary
-		err := function(m.primaryBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
-				return m.runFunction0(function)
-			}
-			// If it's a different error, just return it
-			return err
-		}
-		// If there's no error, return the result
-		return nil
-	}
-
-	if m.fallbackReady {
-		// Try to run the function on the fallback
-		err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return fmt.Errorf("all Beacon clients failed")
-			}
-
-			// If it's a different error, just return it
-			return err
-		}
-		// If there's no error, return the result
-		return nil
-	}
-
-	return fmt.Errorf("no Beacon clients were ready")
+	_, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return nil, function(client)
+	})
+	return err
 }
 
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, error) {
 
-	// Check if we can use the primary
-	if m.primaryReady {
-		// Try to run the function on the primary
-		result, err := function(m.primaryBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
-				return m.runFunction1(function)
+	anyReady := false
+	for _, index := range m.getCandidates() {
+		bc := m.clients[index]
+
+		// Make sure the client is synced before routing requests to it; the preferred client was healthy when it last served a request,
+		// so it's only re-checked periodically
+		if !m.ignoreSyncCheck && m.needsStatusCheck(index) {
+			clientStatus := checkBcStatus(bc)
+			if !clientStatus.IsWorking || !clientStatus.IsSynced {
+				m.logger.Printlnf("WARNING: Beacon client %s is not ready (working: %t, synced: %t), skipping it...", m.endpoints[index], clientStatus.IsWorking, clientStatus.IsSynced)
+				m.setChecked(index, false)
+				continue
 			}
-			// If it's a different error, just return it
-			return nil, err
+			m.setChecked(index, true)
 		}
-		// If there's no error, return the result
-		return result, nil
-	}
+		anyReady = true
 
-	if m.fallbackReady {
-		// Try to run the function on the fallback
-		result, err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return nil, fmt.Errorf("all Beacon clients failed")
-			}
-			// If it's a different error, just return it
-			return nil, err
+		// Try to run the function on the client
+		result, err := function(bc)
+		if err != nil && m.isDisconnected(err) {
+			// If it's disconnected, log it and try the next client
+			m.logger.Printlnf("WARNING: Beacon client %s disconnected (%s), trying the next one...", m.endpoints[index], err.Error())
+			m.setReady(index, false)
+			continue
 		}
-		// If there's no error, return the result
-		return result, nil
+
+		// The client is responsive, so prefer it for future requests; if it's a different error, just return it
+		m.setServed(index)
+		return result, err
 	}
 
-	return nil, fmt.Errorf("no Beacon clients were ready")
+	if !anyReady {
+		return nil, fmt.Errorf("no Beacon clients were ready")
+	}
+	return nil, fmt.Errorf("all Beacon clients failed")
 
 }
 
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, interface{}, error) {
+	var result2 interface{}
+	result1, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		var result1 interface{}
+		var err error
+		result1, result2, err = function(client)
+		return result1, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return result1, result2, nil
+}
 
-	// Check if we can use the primary
-	if m.primaryReady {
-		// Try to run the function on the primary
-		result1, result2, err := function(m.primaryBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
-				return m.runFunction2(function)
-			}
-			// If it's a different error, just return it
-			return nil, nil, err
+// Get the indices of the ready clients in the order they should be tried: the preferred client first, then the rest in order.
+// If a fallback is preferred, the primary is tried first whenever it's due for a status check, so it can take over again once it recovers.
+func (m *BeaconClientManager) getCandidates() []int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	candidates := make([]int, 0, len(m.clients))
+	retryPrimary := m.preferred != 0 && !m.ignoreSyncCheck && !m.fallbacksForced && time.Since(m.checked[0]) >= bcStatusCheckInterval
+	if retryPrimary {
+		candidates = append(candidates, 0)
+	}
+	if m.ready[m.preferred] {
+		candidates = append(candidates, m.preferred)
+	}
+	for i := range m.clients {
+		if i != m.preferred && m.ready[i] && !(i == 0 && retryPrimary) {
+			candidates = append(candidates, i)
 		}
-		// If there's no error, return the result
-		return result1, result2, nil
 	}
+	return candidates
+}
 
-	if m.fallbackReady {
-		// Try to run the function on the fallback
-		result1, result2, err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return nil, nil, fmt.Errorf("all Beacon clients failed")
-			}
-			// If it's a different error, just return it
-			return nil, nil, err
+// Check whether a client's sync status needs to be checked before it's used; that's every time for clients other than the preferred one
+func (m *BeaconClientManager) needsStatusCheck(index int) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return index != m.preferred || time.Since(m.checked[index]) >= bcStatusCheckInterval
+}
+
+// Record the result of a client's sync status check
+func (m *BeaconClientManager) setChecked(index int, ready bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.ready[index] = ready
+	m.checked[index] = time.Now()
+}
+
+// Check whether a client is ready to serve requests
+func (m *BeaconClientManager) isReady(index int) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.ready[index]
+}
+
+// Check whether any of the fallback clients are ready to serve requests
+func (m *BeaconClientManager) isFallbackReady() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, ready := range m.ready[1:] {
+		if ready {
+			return true
 		}
-		// If there's no error, return the result
-		return result1, result2, nil
 	}
+	return false
+}
 
-	return nil, nil, fmt.Errorf("no Beacon clients were ready")
+// Set whether a client is ready to serve requests
+func (m *BeaconClientManager) setReady(index int, ready bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.ready[index] = ready
+}
 
+// Record that a client served a request, making it the preferred client
+func (m *BeaconClientManager) setServed(index int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.preferred = index
+	m.lastEndpoint = m.endpoints[index]
 }
 
 // Returns true if the error was a connection failure and a backup client is available
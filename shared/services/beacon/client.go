@@ -1,11 +1,18 @@
 package beacon
 
 import (
+	"context"
+	"math/big"
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/rocket-pool/rocketpool-go/types"
 )
 
+// The epoch used by the Beacon chain to represent "never", e.g. for forks that haven't been scheduled
+const FarFutureEpoch uint64 = 18446744073709551615
+
 // API request options
 type ValidatorStatusOptions struct {
 	Epoch *uint64
@@ -14,8 +21,37 @@ type ValidatorStatusOptions struct {
 
 // API response types
 type SyncStatus struct {
-	Syncing  bool
-	Progress float64
+	Syncing      bool
+	Progress     float64
+	IsOptimistic bool
+	ElOffline    bool
+}
+type NodeVersion struct {
+	Raw            string
+	Implementation string
+	Version        string
+}
+type NodeIdentity struct {
+	PeerID             string
+	Enr                string
+	P2PAddresses       []string
+	DiscoveryAddresses []string
+	SeqNumber          uint64
+	Attnets            []byte
+	Syncnets           []byte
+}
+type PeerCount struct {
+	Disconnected  uint64
+	Connecting    uint64
+	Connected     uint64
+	Disconnecting uint64
+}
+type Peer struct {
+	PeerID    string
+	Enr       string
+	Address   string
+	State     string
+	Direction string
 }
 type Eth2Config struct {
 	GenesisForkVersion           []byte
@@ -26,16 +62,64 @@ type Eth2Config struct {
 	SlotsPerEpoch                uint64
 	SecondsPerEpoch              uint64
 	EpochsPerSyncCommitteePeriod uint64
+
+	// Validator lifecycle parameters
+	ShardCommitteePeriod             uint64
+	MinValidatorWithdrawabilityDelay uint64
+	ChurnLimitQuotient               uint64
+	MinPerEpochChurnLimit            uint64
+	MaxPerEpochActivationChurnLimit  uint64 // Zero prior to Deneb, where activations share the regular churn limit
+	MaxEffectiveBalance              uint64
+	EjectionBalance                  uint64
+
+	// Balance-based churn parameters from Electra onwards, in gwei
+	EffectiveBalanceIncrement           uint64
+	MinPerEpochChurnLimitElectra        uint64
+	MaxPerEpochActivationExitChurnLimit uint64
+
+	// Fork schedule; unscheduled forks are set to FarFutureEpoch
+	DenebForkEpoch   uint64
+	ElectraForkEpoch uint64
+}
+type Fork struct {
+	PreviousVersion []byte
+	CurrentVersion  []byte
+	Epoch           uint64
 }
 type Eth2DepositContract struct {
 	ChainID uint64
 	Address common.Address
 }
+type DepositSnapshot struct {
+	Finalized            []common.Hash
+	DepositRoot          common.Hash
+	DepositCount         uint64
+	ExecutionBlockHash   common.Hash
+	ExecutionBlockHeight uint64
+}
 type BeaconHead struct {
+	Slot                   uint64 // The slot of the node's head block; this lags behind Epoch while the node is syncing
 	Epoch                  uint64
 	FinalizedEpoch         uint64
 	JustifiedEpoch         uint64
 	PreviousJustifiedEpoch uint64
+	FinalizedRoot          common.Hash
+	JustifiedRoot          common.Hash
+	PreviousJustifiedRoot  common.Hash
+}
+
+// An estimate of when a validator that submits an exit now would exit and become withdrawable.
+// Use Eth2Config.EpochToTime to convert the epochs to wall-clock times.
+type ExitTimeEstimate struct {
+	CurrentEpoch      uint64
+	ExitEpoch         uint64
+	WithdrawableEpoch uint64
+}
+
+// The latest finalized checkpoint, and whether it has advanced since it was last checked
+type FinalizedCheckpointUpdate struct {
+	Checkpoint Checkpoint
+	Advanced   bool
 }
 type ValidatorStatus struct {
 	Pubkey                     types.ValidatorPubkey
@@ -56,13 +140,25 @@ type Eth1Data struct {
 	DepositCount uint64
 	BlockHash    common.Hash
 }
+
+// The execution fields are only set if HasExecutionPayload is true; BaseFeePerGas is nil otherwise
 type BeaconBlock struct {
 	Slot                 uint64
 	ProposerIndex        string
+	Graffiti             string
 	HasExecutionPayload  bool
 	Attestations         []AttestationInfo
 	FeeRecipient         common.Address
 	ExecutionBlockNumber uint64
+	ExecutionBlockHash   common.Hash
+	GasLimit             uint64
+	GasUsed              uint64
+	BaseFeePerGas        *big.Int
+	Timestamp            uint64
+	TransactionCount     uint64
+	BlobGasUsed          uint64
+	ExcessBlobGas        uint64
+	Withdrawals          []Withdrawal
 }
 
 // Committees is an interface as an optimization- since committees responses
@@ -82,18 +178,296 @@ type Committees interface {
 	Validators(int) []string
 	// Count returns the number of committees in the response
 	Count() int
+	// FindValidator returns the slot and index of the committee the
+	// validator with the provided index is in, and its position within
+	// that committee
+	FindValidator(index string) (slot uint64, committeeIndex uint64, positionInCommittee int, found bool)
 	// Release returns the reused validators slice buffer to the pool for
 	// further reuse, and must be called when the user is done with this
 	// committees instance
 	Release()
 }
 
+type BlobSidecar struct {
+	Index                       uint64
+	Slot                        uint64
+	Blob                        []byte
+	KzgCommitment               []byte
+	KzgProof                    []byte
+	KzgCommitmentInclusionProof [][]byte
+}
+
+type BlockRewards struct {
+	ProposerIndex     string
+	Total             uint64
+	Attestations      uint64
+	SyncAggregate     uint64
+	ProposerSlashings uint64
+	AttesterSlashings uint64
+}
+
+type AttestationRewards struct {
+	IdealRewards []IdealAttestationRewards
+	TotalRewards []ValidatorAttestationRewards
+}
+type IdealAttestationRewards struct {
+	EffectiveBalance uint64
+	Head             int64
+	Target           int64
+	Source           int64
+	InclusionDelay   int64
+	Inactivity       int64
+}
+type ValidatorAttestationRewards struct {
+	ValidatorIndex string
+	Head           int64
+	Target         int64
+	Source         int64
+	InclusionDelay int64
+	Inactivity     int64
+}
+
+type Withdrawal struct {
+	Index          uint64
+	ValidatorIndex string
+	Address        common.Address
+	Amount         uint64
+}
+
 type AttestationInfo struct {
 	AggregationBits bitfield.Bitlist
 	SlotIndex       uint64
 	CommitteeIndex  uint64
+	BeaconBlockRoot common.Hash
+	Source          Checkpoint
+	Target          Checkpoint
+}
+
+type Attestation struct {
+	AggregationBits bitfield.Bitlist
+	Data            AttestationData
+	Signature       types.ValidatorSignature
+}
+
+type ProposerPreparation struct {
+	ValidatorIndex string
+	FeeRecipient   common.Address
+}
+
+// A signed builder registration for a validator
+type SignedValidatorRegistration struct {
+	FeeRecipient common.Address
+	GasLimit     uint64
+	Timestamp    uint64
+	Pubkey       types.ValidatorPubkey
+	Signature    types.ValidatorSignature
+}
+
+// A sync committee member's signature over the head block root at a slot
+type SyncCommitteeMessage struct {
+	Slot            uint64
+	BeaconBlockRoot common.Hash
+	ValidatorIndex  string
+	Signature       types.ValidatorSignature
+}
+
+// A request for the node to subscribe to the sync committee subnets of a validator until the provided epoch.
+// SyncCommitteeIndices are the validator's positions in the sync committee, as reported by its sync duties.
+type SyncCommitteeSubscription struct {
+	ValidatorIndex       string
+	SyncCommitteeIndices []uint64
+	UntilEpoch           uint64
+}
+
+// A request for the node to subscribe to the attestation subnet of a validator's beacon committee for the provided slot.
+// The committee fields come from the validator's attester duties.
+type BeaconCommitteeSubscription struct {
+	ValidatorIndex   string
+	CommitteeIndex   uint64
+	CommitteesAtSlot uint64
+	Slot             uint64
+	IsAggregator     bool
+}
+
+// An unsigned block produced by the node. Block is the raw JSON of the block, whose structure depends on Version.
+type ProducedBlock struct {
+	Version string
+	Block   []byte
 }
 
+// An unsigned blinded block produced by the node, with the fee recipient and gas limit of the payload it commits to
+type ProducedBlindedBlock struct {
+	ProducedBlock
+	FeeRecipient common.Address
+	GasLimit     uint64
+}
+
+type Checkpoint struct {
+	Epoch uint64
+	Root  common.Hash
+}
+type AttestationData struct {
+	Slot            uint64
+	Index           uint64
+	BeaconBlockRoot common.Hash
+	Source          Checkpoint
+	Target          Checkpoint
+}
+type IndexedAttestation struct {
+	AttestingIndices []string
+	Data             AttestationData
+	Signature        types.ValidatorSignature
+}
+type AttesterSlashing struct {
+	Attestation1 IndexedAttestation
+	Attestation2 IndexedAttestation
+}
+type BeaconBlockHeader struct {
+	Slot          uint64
+	ProposerIndex string
+	ParentRoot    common.Hash
+	StateRoot     common.Hash
+	BodyRoot      common.Hash
+}
+type SignedBeaconBlockHeader struct {
+	Message   BeaconBlockHeader
+	Signature types.ValidatorSignature
+}
+type BlockHeader struct {
+	Root      common.Hash
+	Canonical bool
+	Header    BeaconBlockHeader
+}
+type SyncCommittee struct {
+	Validators          []string
+	ValidatorAggregates [][]string
+}
+
+// The dependent root changes whenever a reorg invalidates the duties, so callers can skip recomputation while it stays the same
+type ProposerDuties struct {
+	DependentRoot       common.Hash
+	ExecutionOptimistic bool
+	Duties              []ProposerDuty
+}
+type ProposerDuty struct {
+	Pubkey         types.ValidatorPubkey
+	ValidatorIndex string
+	Slot           uint64
+}
+type AttesterDuties struct {
+	DependentRoot       common.Hash
+	ExecutionOptimistic bool
+	Duties              []AttesterDuty
+}
+type AttesterDuty struct {
+	Pubkey                  types.ValidatorPubkey
+	ValidatorIndex          string
+	CommitteeIndex          uint64
+	CommitteeLength         uint64
+	CommitteesAtSlot        uint64
+	ValidatorCommitteeIndex uint64
+	Slot                    uint64
+}
+type SignedVoluntaryExit struct {
+	ValidatorIndex string
+	Epoch          uint64
+	Signature      types.ValidatorSignature
+}
+type SignedBLSToExecutionChange struct {
+	ValidatorIndex     string
+	FromBLSPubkey      types.ValidatorPubkey
+	ToExecutionAddress common.Address
+	Signature          types.ValidatorSignature
+}
+type ProposerSlashing struct {
+	SignedHeader1 SignedBeaconBlockHeader
+	SignedHeader2 SignedBeaconBlockHeader
+}
+
+// Beacon node event stream topics
+const (
+	EventTopic_Head                = "head"
+	EventTopic_Block               = "block"
+	EventTopic_Attestation         = "attestation"
+	EventTopic_VoluntaryExit       = "voluntary_exit"
+	EventTopic_FinalizedCheckpoint = "finalized_checkpoint"
+	EventTopic_ChainReorg          = "chain_reorg"
+)
+
+// An event from the Beacon node's event stream.
+// Only the field that corresponds to the event's topic is set, or Err if the event's payload couldn't be decoded.
+type Event struct {
+	Topic               string
+	Err                 error
+	Head                *HeadEvent
+	Block               *BlockEvent
+	Attestation         *AttestationInfo
+	VoluntaryExit       *SignedVoluntaryExit
+	FinalizedCheckpoint *FinalizedCheckpointEvent
+	ChainReorg          *ChainReorgEvent
+}
+type HeadEvent struct {
+	Slot                      uint64
+	Block                     common.Hash
+	State                     common.Hash
+	EpochTransition           bool
+	PreviousDutyDependentRoot common.Hash
+	CurrentDutyDependentRoot  common.Hash
+	ExecutionOptimistic       bool
+}
+type BlockEvent struct {
+	Slot                uint64
+	Block               common.Hash
+	ExecutionOptimistic bool
+}
+type FinalizedCheckpointEvent struct {
+	Block               common.Hash
+	State               common.Hash
+	Epoch               uint64
+	ExecutionOptimistic bool
+}
+
+type ChainReorgEvent struct {
+	Slot         uint64
+	Depth        uint64
+	OldHeadBlock common.Hash
+	NewHeadBlock common.Hash
+	Epoch        uint64
+}
+
+// Get the first slot whose cached block data may be stale after the reorg
+func (e ChainReorgEvent) FirstAffectedSlot() uint64 {
+	if e.Depth > e.Slot {
+		return 0
+	}
+	return e.Slot - e.Depth
+}
+
+// Beacon node implementations, as reported by their version strings
+const (
+	Implementation_Lighthouse = "lighthouse"
+	Implementation_Prysm      = "prysm"
+	Implementation_Teku       = "teku"
+	Implementation_Nimbus     = "nimbus"
+	Implementation_Lodestar   = "lodestar"
+	Implementation_Grandine   = "grandine"
+	Implementation_Unknown    = "unknown"
+)
+
+// Beacon node health, as reported by its health endpoint
+type HealthStatus int
+
+const (
+	// The node is synced and ready to serve requests
+	HealthStatus_Healthy HealthStatus = iota
+
+	// The node is running but still syncing, so its data may be incomplete
+	HealthStatus_Syncing
+
+	// The node is not initialized or has a problem
+	HealthStatus_Unhealthy
+)
+
 // Beacon client type
 type BeaconClientType int
 
@@ -129,22 +503,79 @@ const (
 // Beacon client interface
 type Client interface {
 	GetClientType() (BeaconClientType, error)
-	GetSyncStatus() (SyncStatus, error)
-	GetEth2Config() (Eth2Config, error)
-	GetEth2DepositContract() (Eth2DepositContract, error)
-	GetAttestations(blockId string) ([]AttestationInfo, bool, error)
-	GetBeaconBlock(blockId string) (BeaconBlock, bool, error)
-	GetBeaconHead() (BeaconHead, error)
-	GetValidatorStatusByIndex(index string, opts *ValidatorStatusOptions) (ValidatorStatus, error)
-	GetValidatorStatus(pubkey types.ValidatorPubkey, opts *ValidatorStatusOptions) (ValidatorStatus, error)
-	GetValidatorStatuses(pubkeys []types.ValidatorPubkey, opts *ValidatorStatusOptions) (map[types.ValidatorPubkey]ValidatorStatus, error)
-	GetValidatorIndex(pubkey types.ValidatorPubkey) (string, error)
-	GetValidatorSyncDuties(indices []string, epoch uint64) (map[string]bool, error)
-	GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error)
-	GetDomainData(domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error)
-	ExitValidator(validatorIndex string, epoch uint64, signature types.ValidatorSignature) error
+	GetSyncStatus(ctx context.Context) (SyncStatus, error)
+	GetHeadSlot(ctx context.Context) (uint64, error)
+	GetHealth(ctx context.Context, syncingStatus int) (HealthStatus, error)
+	GetNodeVersion(ctx context.Context) (NodeVersion, error)
+	RefreshConfig()
+	GetEth2Config(ctx context.Context) (Eth2Config, error)
+	GetEth2DepositContract(ctx context.Context) (Eth2DepositContract, error)
+	GetForkSchedule(ctx context.Context) ([]Fork, error)
+	GetDepositSnapshot(ctx context.Context) (DepositSnapshot, bool, error)
+	GetAttestations(ctx context.Context, blockId string) ([]AttestationInfo, bool, error)
+	ProduceBlock(ctx context.Context, slot uint64, randaoReveal types.ValidatorSignature, graffiti []byte) (ProducedBlock, error)
+	ProduceBlindedBlock(ctx context.Context, slot uint64, randaoReveal types.ValidatorSignature, graffiti []byte) (ProducedBlindedBlock, error)
+	SupportsBlindedBlocks(ctx context.Context) (bool, error)
+	GetFeeRecipient(ctx context.Context, pubkey types.ValidatorPubkey) (common.Address, error)
+	PrepareBeaconProposer(ctx context.Context, proposers []ProposerPreparation) error
+	RegisterValidators(ctx context.Context, registrations []SignedValidatorRegistration) error
+	SubmitAttestations(ctx context.Context, attestations []Attestation) error
+	SubmitSyncCommitteeMessages(ctx context.Context, messages []SyncCommitteeMessage) error
+	SubscribeToSyncCommitteeSubnets(ctx context.Context, subscriptions []SyncCommitteeSubscription) error
+	SubscribeToBeaconCommitteeSubnets(ctx context.Context, subscriptions []BeaconCommitteeSubscription) error
+	GetAttestationData(ctx context.Context, slot uint64, committeeIndex uint64) (AttestationData, error)
+	GetAggregateAttestation(ctx context.Context, attestationDataRoot common.Hash, slot uint64) (Attestation, bool, error)
+	GetBeaconBlock(ctx context.Context, blockId string) (BeaconBlock, bool, error)
+	GetBeaconHead(ctx context.Context) (BeaconHead, error)
+	CheckFinalizedCheckpoint(ctx context.Context) (FinalizedCheckpointUpdate, error)
+	WaitForSlot(ctx context.Context, slot uint64, pollInterval time.Duration) error
+	WaitForEpoch(ctx context.Context, epoch uint64, pollInterval time.Duration) error
+	EstimateActivationTime(ctx context.Context, activationEligibilityEpoch uint64) (uint64, error)
+	EstimateExitTime(ctx context.Context) (ExitTimeEstimate, error)
+	GetValidatorStatusByIndex(ctx context.Context, index string, opts *ValidatorStatusOptions) (ValidatorStatus, error)
+	GetValidatorStatus(ctx context.Context, pubkey types.ValidatorPubkey, opts *ValidatorStatusOptions) (ValidatorStatus, error)
+	GetValidatorStatuses(ctx context.Context, pubkeys []types.ValidatorPubkey, opts *ValidatorStatusOptions) (map[types.ValidatorPubkey]ValidatorStatus, error)
+	GetValidator(ctx context.Context, stateId string, validatorId string) (ValidatorStatus, error)
+	GetValidatorsChunked(ctx context.Context, stateId string, chunkSize int, progress func(done int, total int)) ([]ValidatorStatus, error)
+	GetValidatorsByStatus(ctx context.Context, stateId string, statuses []ValidatorState) ([]ValidatorStatus, error)
+	GetValidatorIndex(ctx context.Context, pubkey types.ValidatorPubkey) (string, error)
+	GetValidatorIndices(ctx context.Context, pubkeys []types.ValidatorPubkey) (map[string]string, error)
+	GetValidatorSyncDuties(ctx context.Context, indices []string, epoch uint64) (map[string]bool, error)
+	GetValidatorProposerDuties(ctx context.Context, indices []string, epoch uint64) (map[string]uint64, error)
+	GetProposerDuties(ctx context.Context, epoch uint64) (ProposerDuties, error)
+	GetAttesterDuties(ctx context.Context, epoch uint64, indices []string) (AttesterDuties, error)
+	GetDomainData(ctx context.Context, domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error)
+	ExitValidator(ctx context.Context, validatorIndex string, epoch uint64, signature types.ValidatorSignature) error
 	Close() error
-	GetEth1DataForEth2Block(blockId string) (Eth1Data, bool, error)
-	GetCommitteesForEpoch(epoch *uint64) (Committees, error)
-	ChangeWithdrawalCredentials(validatorIndex string, fromBlsPubkey types.ValidatorPubkey, toExecutionAddress common.Address, signature types.ValidatorSignature) error
+	GetEth1DataForEth2Block(ctx context.Context, blockId string) (Eth1Data, bool, error)
+	GetCommitteesForEpoch(ctx context.Context, epoch *uint64) (Committees, error)
+	GetCommittees(ctx context.Context, stateId string, epoch *uint64, index *uint64, slot *uint64) (Committees, error)
+	GetSyncCommittees(ctx context.Context, stateId string, epoch *uint64) (SyncCommittee, error)
+	SupportsHistoricalStates(ctx context.Context) (bool, error)
+	GetEarliestAvailableSlot(ctx context.Context) (uint64, error)
+	GetStateRoot(ctx context.Context, stateId string) (common.Hash, error)
+	GetRandao(ctx context.Context, stateId string, epoch *uint64) (common.Hash, error)
+	GetBlockHeader(ctx context.Context, blockId string) (BlockHeader, bool, error)
+	GetBeaconBlocks(ctx context.Context, slots []uint64, concurrency int) (map[uint64]*BeaconBlock, error)
+	GetBeaconBlockByRoot(ctx context.Context, root common.Hash) (BeaconBlock, bool, error)
+	GetBlockHeaders(ctx context.Context, slot *uint64, parentRoot *common.Hash) ([]BlockHeader, error)
+	Events(ctx context.Context, topics []string) (<-chan Event, error)
+	SubmitAttesterSlashing(ctx context.Context, slashing AttesterSlashing) error
+	SubmitProposerSlashing(ctx context.Context, slashing ProposerSlashing) error
+	GetPoolAttesterSlashings(ctx context.Context) ([]AttesterSlashing, error)
+	GetPoolProposerSlashings(ctx context.Context) ([]ProposerSlashing, error)
+	GetPoolVoluntaryExits(ctx context.Context) ([]SignedVoluntaryExit, error)
+	SubmitBLSToExecutionChanges(ctx context.Context, changes []SignedBLSToExecutionChange) error
+	GetPoolBLSToExecutionChanges(ctx context.Context) ([]SignedBLSToExecutionChange, error)
+	ChangeWithdrawalCredentials(ctx context.Context, validatorIndex string, fromBlsPubkey types.ValidatorPubkey, toExecutionAddress common.Address, signature types.ValidatorSignature) error
+	GetBlobSidecars(ctx context.Context, blockId string, indices []uint64) ([]BlobSidecar, bool, error)
+	GetValidatorBalances(ctx context.Context, stateId string, pubkeysOrIndices []string) (map[string]uint64, error)
+	GetExpectedWithdrawals(ctx context.Context, stateId string) ([]Withdrawal, error)
+	GetBlockRewards(ctx context.Context, blockId string) (BlockRewards, bool, error)
+	GetAttestationRewards(ctx context.Context, epoch uint64, indices []string) (AttestationRewards, error)
+	GetSyncCommitteeRewards(ctx context.Context, blockId string, indices []string) (map[string]int64, bool, error)
+	GetValidatorLiveness(ctx context.Context, epoch uint64, indices []string) (map[string]bool, error)
+	GetNodeIdentity(ctx context.Context) (NodeIdentity, error)
+	GetPeerCount(ctx context.Context) (PeerCount, error)
+	GetPeers(ctx context.Context, states []string, directions []string) ([]Peer, error)
 }
@@ -0,0 +1,45 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+// A 400 for attestation data is only a future slot if the node says so or the slot is past the wall clock; bad committee indices are plain errors
+func TestGetAttestationDataSlotInFuture(t *testing.T) {
+	tests := []struct {
+		name     string
+		slot     uint64
+		message  string
+		inFuture bool
+	}{
+		{name: "future slot", slot: 2000, message: "BAD_REQUEST: invalid request", inFuture: true},
+		{name: "future slot by message", slot: 1000, message: "BAD_REQUEST: request slot 1000 is ahead of the current slot 999", inFuture: true},
+		{name: "bad committee index", slot: 500, message: "BAD_REQUEST: invalid committee index 99 for slot 500", inFuture: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := clienttest.NewServer()
+			defer server.Close()
+			// Slot 1000 is the current wall clock slot
+			server.SetGenesis(clienttest.Genesis{Time: uint64(time.Now().Unix()) - 1000*12, ForkVersion: []byte{0, 0, 0, 0}})
+			server.SetError(client.RequestAttestationDataPath, http.StatusBadRequest, test.message, 0)
+
+			bc := client.NewStandardHttpClient(server.URL)
+			_, err := bc.GetAttestationData(context.Background(), test.slot, 99)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if errors.Is(err, beacon.ErrSlotInFuture) != test.inFuture {
+				t.Errorf("expected errors.Is(err, ErrSlotInFuture) to be %t, got error: %s", test.inFuture, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+)
+
+// A balances request as the node saw it
+type balancesRequest struct {
+	method    string
+	urlLength int
+	ids       []string
+}
+
+// Start a node that serves a balance of 32 ETH for every requested validator ID and records how they were requested.
+// If it's an older Prysm node, it reports itself as one and rejects POST requests for balances.
+func newBalancesServer(t *testing.T, olderPrysm bool) (*httptest.Server, func() []balancesRequest) {
+	var lock sync.Mutex
+	var requests []balancesRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if olderPrysm && r.URL.Path == client.RequestNodeVersionPath {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{"version":"Prysm/v4.0.8/dd21b8a4e8b5b2e5b4d2e5c1f3f03c0e1ca2a2b4"}}`))
+			return
+		}
+		if r.URL.Path != fmt.Sprintf(client.RequestValidatorBalancesPath, "head") {
+			http.NotFound(w, r)
+			return
+		}
+		request := balancesRequest{method: r.Method, urlLength: len(r.URL.RequestURI())}
+		if olderPrysm && r.Method == http.MethodPost {
+			lock.Lock()
+			requests = append(requests, request)
+			lock.Unlock()
+			http.Error(w, `{"code":405,"message":"Method Not Allowed"}`, http.StatusMethodNotAllowed)
+			return
+		}
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &request.ids); err != nil {
+				t.Errorf("error decoding POST body: %s", err.Error())
+			}
+		} else if ids := r.URL.Query().Get("id"); ids != "" {
+			request.ids = strings.Split(ids, ",")
+		}
+		lock.Lock()
+		requests = append(requests, request)
+		lock.Unlock()
+
+		data := make([]map[string]string, len(request.ids))
+		for i, id := range request.ids {
+			data[i] = map[string]string{"index": id, "balance": "32000000000"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	return server, func() []balancesRequest {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]balancesRequest{}, requests...)
+	}
+}
+
+// Pubkeys in hex, which are about 100 characters each in the query string
+func balancePubkeys(count int) []string {
+	pubkeys := make([]string, count)
+	for i := range pubkeys {
+		pubkeys[i] = fmt.Sprintf("0x%096x", i+1)
+	}
+	return pubkeys
+}
+
+func TestGetValidatorBalancesMethod(t *testing.T) {
+	tests := []struct {
+		name   string
+		ids    []string
+		method string
+	}{
+		{name: "few pubkeys", ids: balancePubkeys(10), method: http.MethodGet},
+		{name: "pubkeys past the query length limit", ids: balancePubkeys(100), method: http.MethodPost},
+		{name: "many indices within the query length limit", ids: strings.Split(strings.Repeat("1234,", 1000)+"1234", ","), method: http.MethodGet},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, requests := newBalancesServer(t, false)
+			defer server.Close()
+			bc := client.NewStandardHttpClient(server.URL)
+
+			if _, err := bc.GetValidatorBalances(context.Background(), "head", test.ids); err != nil {
+				t.Fatalf("error getting balances: %s", err.Error())
+			}
+			made := requests()
+			if len(made) != 1 || made[0].method != test.method || len(made[0].ids) != len(test.ids) {
+				t.Fatalf("expected a single %s request for %d IDs, got %+v", test.method, len(test.ids), made)
+			}
+			if made[0].urlLength > 8192 {
+				t.Errorf("expected the URL to fit in 8KB, got %d bytes", made[0].urlLength)
+			}
+		})
+	}
+}
+
+func TestGetValidatorBalancesPrysmFallback(t *testing.T) {
+	server, requests := newBalancesServer(t, true)
+	defer server.Close()
+	bc := client.NewStandardHttpClient(server.URL)
+
+	// 1000 pubkeys are about 100KB of query string, so they need about 17 GET requests
+	pubkeys := balancePubkeys(1000)
+	balances, err := bc.GetValidatorBalances(context.Background(), "head", pubkeys)
+	if err != nil {
+		t.Fatalf("error getting balances: %s", err.Error())
+	}
+	if len(balances) != len(pubkeys) {
+		t.Fatalf("expected %d balances, got %d", len(pubkeys), len(balances))
+	}
+	for _, pubkey := range pubkeys {
+		if balances[pubkey] != 32e9 {
+			t.Fatalf("expected a balance for %s", pubkey)
+		}
+	}
+
+	made := requests()
+	if len(made) < 2 || made[0].method != http.MethodPost {
+		t.Fatalf("expected a POST request followed by GET requests, got %+v", made)
+	}
+	requested := 0
+	for _, request := range made[1:] {
+		if request.method != http.MethodGet {
+			t.Errorf("expected only GET requests after the POST was rejected, got %s", request.method)
+		}
+		if request.urlLength > 8192 {
+			t.Errorf("expected every URL to fit in 8KB, got %d bytes", request.urlLength)
+		}
+		requested += len(request.ids)
+	}
+	if requested != len(pubkeys) {
+		t.Errorf("expected %d pubkeys to be requested over GET, got %d", len(pubkeys), requested)
+	}
+	if len(made)-1 > 20 {
+		t.Errorf("expected the pubkeys to be packed into as few requests as fit, got %d", len(made)-1)
+	}
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// How often the node's finalized epoch is refreshed when deciding whether a block is safe to cache
+const finalizedEpochRefreshInterval = time.Minute
+
+// An LRU cache of blocks, keyed by slot
+type blockCache struct {
+	size               int
+	includeUnfinalized bool
+
+	entries map[uint64]*list.Element
+	order   *list.List
+	lock    sync.Mutex
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type blockCacheEntry struct {
+	slot  uint64
+	block BeaconBlockResponse
+}
+
+// Cache up to size blocks by slot, so repeated requests for the same block don't go back to the node.
+// By default only finalized blocks are cached, since anything newer can be reorged out; set includeUnfinalized to cache
+// those too. Reorged blocks are only dropped from the cache while this client's Events stream is being consumed with the
+// chain_reorg topic, so without one, includeUnfinalized can serve blocks that are no longer canonical.
+func WithBlockCache(size int, includeUnfinalized bool) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		c.blockCache = &blockCache{
+			size:               size,
+			includeUnfinalized: includeUnfinalized,
+			entries:            map[uint64]*list.Element{},
+			order:              list.New(),
+		}
+	}
+}
+
+// Get the number of block cache hits and misses so far. Both are zero if the cache isn't enabled.
+func (c *StandardHttpClient) BlockCacheStats() (hits uint64, misses uint64) {
+	if c.blockCache == nil {
+		return 0, 0
+	}
+	return c.blockCache.hits.Load(), c.blockCache.misses.Load()
+}
+
+// Get a block from the cache
+func (b *blockCache) get(slot uint64) (BeaconBlockResponse, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	element, exists := b.entries[slot]
+	if !exists {
+		b.misses.Add(1)
+		return BeaconBlockResponse{}, false
+	}
+	b.hits.Add(1)
+	b.order.MoveToFront(element)
+	return element.Value.(*blockCacheEntry).block, true
+}
+
+// Add a block to the cache, evicting the least recently used one if it's full
+func (b *blockCache) add(slot uint64, block BeaconBlockResponse) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if element, exists := b.entries[slot]; exists {
+		element.Value.(*blockCacheEntry).block = block
+		b.order.MoveToFront(element)
+		return
+	}
+	b.entries[slot] = b.order.PushFront(&blockCacheEntry{
+		slot:  slot,
+		block: block,
+	})
+	for b.order.Len() > b.size {
+		oldest := b.order.Back()
+		b.order.Remove(oldest)
+		delete(b.entries, oldest.Value.(*blockCacheEntry).slot)
+	}
+}
+
+// Drop every cached block at or after the provided slot
+func (b *blockCache) invalidateFrom(slot uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for cachedSlot, element := range b.entries {
+		if cachedSlot >= slot {
+			b.order.Remove(element)
+			delete(b.entries, cachedSlot)
+		}
+	}
+}
+
+// Get a block by slot, using the cache if it's enabled
+func (c *StandardHttpClient) getBeaconBlockCached(ctx context.Context, blockId string) (BeaconBlockResponse, error) {
+	slot, err := strconv.ParseUint(blockId, 10, 64)
+	if c.blockCache == nil || err != nil {
+		// Only slot numbers can be cached; named blocks like "head" move, and roots aren't worth indexing separately
+		return c.getBeaconBlock(ctx, blockId)
+	}
+
+	if block, exists := c.blockCache.get(slot); exists {
+		return block, nil
+	}
+	block, err := c.getBeaconBlock(ctx, blockId)
+	if err != nil {
+		return BeaconBlockResponse{}, err
+	}
+	if c.blockCache.includeUnfinalized || c.isSlotFinalized(ctx, slot) {
+		c.blockCache.add(slot, block)
+	}
+	return block, nil
+}
+
+// Check if the provided slot is known to be finalized, refreshing the node's finalized epoch if it's out of date
+func (c *StandardHttpClient) isSlotFinalized(ctx context.Context, slot uint64) bool {
+	config, err := c.getEth2Config(ctx)
+	if err != nil {
+		return false
+	}
+	slotsPerEpoch := uint64(config.Data.SlotsPerEpoch)
+	if slot <= c.finalizedEpoch.Load()*slotsPerEpoch {
+		return true
+	}
+
+	// The finalized epoch only moves forward, so a stale value just means caching less than we could
+	lastRefresh := time.Unix(c.finalizedEpochRefreshed.Load(), 0)
+	if time.Since(lastRefresh) < finalizedEpochRefreshInterval {
+		return false
+	}
+	if _, err := c.getFinalityCheckpoints(ctx, "head"); err != nil {
+		return false
+	}
+	return slot <= c.finalizedEpoch.Load()*slotsPerEpoch
+}
+
+// Record the node's finalized epoch if it's newer than the one already known
+func (c *StandardHttpClient) setFinalizedEpoch(epoch uint64) {
+	c.finalizedEpochRefreshed.Store(time.Now().Unix())
+	for {
+		current := c.finalizedEpoch.Load()
+		if epoch <= current || c.finalizedEpoch.CompareAndSwap(current, epoch) {
+			return
+		}
+	}
+}
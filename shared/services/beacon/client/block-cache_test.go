@@ -0,0 +1,42 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+func TestBlockCacheFinalizedBlock(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	server.SetBlock("100", clienttest.Block{Slot: 100, ProposerIndex: 7})
+
+	// Epoch 10 is finalized, so slot 100 is too
+	root := "0x4d8f3c7a6d7b3fa0a6f8e0b7d5f6c2b1e9b1fa2c6a0e3e9dd0b4c7a1f2e3d4c5"
+	server.SetData(fmt.Sprintf(client.RequestFinalityCheckpointsPath, "head"), map[string]any{
+		"previous_justified": map[string]string{"epoch": "11", "root": root},
+		"current_justified":  map[string]string{"epoch": "12", "root": root},
+		"finalized":          map[string]string{"epoch": "10", "root": root},
+	})
+
+	bc := client.NewStandardHttpClient(server.URL, client.WithBlockCache(16, false))
+	for i := 0; i < 2; i++ {
+		block, exists, err := bc.GetBeaconBlock(context.Background(), "100")
+		if err != nil {
+			t.Fatalf("fetch %d: error getting block: %s", i, err.Error())
+		}
+		if !exists || block.Slot != 100 || block.ProposerIndex != "7" {
+			t.Fatalf("fetch %d: expected block 100 from proposer 7, got slot %d from proposer %s (exists: %t)", i, block.Slot, block.ProposerIndex, exists)
+		}
+	}
+
+	if count := server.RequestCount(fmt.Sprintf(client.RequestBeaconBlockPath, "100")); count != 1 {
+		t.Errorf("expected 1 block request, got %d", count)
+	}
+	if hits, misses := bc.BlockCacheStats(); hits != 1 || misses != 1 {
+		t.Errorf("expected 1 hit and 1 miss, got %d hits and %d misses", hits, misses)
+	}
+}
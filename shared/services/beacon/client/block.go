@@ -0,0 +1,25 @@
+package client
+
+import (
+	"bytes"
+	"encoding/hex"
+	"unicode"
+	"unicode/utf8"
+
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Get the block's graffiti for display. Trailing null padding is removed; if what's left isn't printable UTF-8,
+// the graffiti is returned as a hex string instead.
+func (r *BeaconBlockResponse) GraffitiString() string {
+	graffiti := bytes.TrimRight(r.Data.Message.Body.Graffiti, "\x00")
+	if !utf8.Valid(graffiti) {
+		return hexutil.AddPrefix(hex.EncodeToString(graffiti))
+	}
+	for _, char := range string(graffiti) {
+		if !unicode.IsPrint(char) {
+			return hexutil.AddPrefix(hex.EncodeToString(graffiti))
+		}
+	}
+	return string(graffiti)
+}
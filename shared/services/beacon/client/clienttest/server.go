@@ -0,0 +1,368 @@
+// Package clienttest provides a mock Beacon node for testing code that uses the standard HTTP client.
+// It serves canned responses for the main endpoints and can inject errors on any of them.
+package clienttest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+)
+
+// A canned response for a single path
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// An injected error that's served instead of the canned response
+type injectedError struct {
+	status    int
+	message   string
+	remaining int // Negative means the error is served until it's cleared
+}
+
+// A mock Beacon node.
+// Responses are keyed by the URL path without the query string, so query parameters (such as validator IDs or committee epochs) don't filter the canned data.
+type Server struct {
+	*httptest.Server
+
+	lock      sync.Mutex
+	responses map[string]Response
+	errors    map[string]*injectedError
+	requests  map[string]int
+	spec      map[string]string
+}
+
+// The sync status reported by the mock node
+type SyncStatus struct {
+	HeadSlot     uint64
+	SyncDistance uint64
+	IsSyncing    bool
+	IsOptimistic bool
+	ElOffline    bool
+}
+
+// The genesis data reported by the mock node
+type Genesis struct {
+	Time                  uint64
+	ForkVersion           []byte
+	GenesisValidatorsRoot common.Hash
+}
+
+// A validator in the mock node's state
+type Validator struct {
+	Index                      uint64
+	Pubkey                     types.ValidatorPubkey
+	WithdrawalCredentials      common.Hash
+	Balance                    uint64
+	EffectiveBalance           uint64
+	Status                     string
+	Slashed                    bool
+	ActivationEligibilityEpoch uint64
+	ActivationEpoch            uint64
+	ExitEpoch                  uint64
+	WithdrawableEpoch          uint64
+}
+
+// A beacon committee in the mock node's state
+type Committee struct {
+	Index      uint64
+	Slot       uint64
+	Validators []uint64
+}
+
+// A beacon block served by the mock node.
+// The execution payload is left out if ExecutionBlockNumber is zero, as with blocks from before the merge.
+type Block struct {
+	Version              string
+	Slot                 uint64
+	ProposerIndex        uint64
+	Graffiti             []byte
+	ExecutionBlockNumber uint64
+	FeeRecipient         common.Address
+}
+
+// The far future epoch, used for validators that haven't exited
+const FarFutureEpoch uint64 = 0xffffffffffffffff
+
+// The default spec values, taken from mainnet
+var defaultSpec = map[string]string{
+	"SECONDS_PER_SLOT":                          "12",
+	"SLOTS_PER_EPOCH":                           "32",
+	"SLOTS_PER_HISTORICAL_ROOT":                 "8192",
+	"EPOCHS_PER_HISTORICAL_VECTOR":              "65536",
+	"EPOCHS_PER_SLASHINGS_VECTOR":               "8192",
+	"EPOCHS_PER_SYNC_COMMITTEE_PERIOD":          "256",
+	"SHARD_COMMITTEE_PERIOD":                    "256",
+	"MIN_VALIDATOR_WITHDRAWABILITY_DELAY":       "256",
+	"CHURN_LIMIT_QUOTIENT":                      "65536",
+	"MIN_PER_EPOCH_CHURN_LIMIT":                 "4",
+	"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT":      "8",
+	"MAX_EFFECTIVE_BALANCE":                     "32000000000",
+	"EJECTION_BALANCE":                          "16000000000",
+	"EFFECTIVE_BALANCE_INCREMENT":               "1000000000",
+	"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA":         "128000000000",
+	"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT": "256000000000",
+	"DENEB_FORK_EPOCH":                          "269568",
+	"ELECTRA_FORK_EPOCH":                        "18446744073709551615",
+}
+
+// Start a mock Beacon node.
+// It reports itself as synced at slot 0 with the mainnet spec, and has no validators, committees, or blocks until they're set.
+// Call Close when finished with it.
+func NewServer() *Server {
+	s := &Server{
+		responses: map[string]Response{},
+		errors:    map[string]*injectedError{},
+		requests:  map[string]int{},
+		spec:      map[string]string{},
+	}
+	for key, value := range defaultSpec {
+		s.spec[key] = value
+	}
+	s.setSpecResponse()
+	s.SetSyncStatus(SyncStatus{})
+	s.SetGenesis(Genesis{ForkVersion: []byte{0, 0, 0, 0}})
+	s.SetValidators("head", nil)
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Serve a request
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	path := r.URL.Path
+	s.requests[path]++
+
+	// Serve an injected error
+	if injected, exists := s.errors[path]; exists {
+		status, message := injected.status, injected.message
+		if injected.remaining > 0 {
+			injected.remaining--
+			if injected.remaining == 0 {
+				delete(s.errors, path)
+			}
+		}
+		s.lock.Unlock()
+		writeError(w, status, message)
+		return
+	}
+
+	// Serve the canned response
+	response, exists := s.responses[path]
+	s.lock.Unlock()
+	if !exists {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no canned response for %s", path))
+		return
+	}
+	for key, values := range response.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(response.Status)
+	_, _ = w.Write(response.Body)
+}
+
+// Write an error in the format used by the Beacon API
+func writeError(w http.ResponseWriter, status int, message string) {
+	body, _ := json.Marshal(map[string]any{
+		"code":    status,
+		"message": message,
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// Set the canned response for a path; this can be used for endpoints without a dedicated setter
+func (s *Server) SetResponse(path string, response Response) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.responses[path] = response
+}
+
+// Set a canned 200 response for a path, encoding data as the response's "data" field
+func (s *Server) SetData(path string, data any) {
+	body, err := json.Marshal(map[string]any{
+		"data": data,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("error encoding canned data for %s: %s", path, err.Error()))
+	}
+	s.SetResponse(path, Response{
+		Status: http.StatusOK,
+		Body:   body,
+	})
+}
+
+// Serve an error for a path instead of its canned response.
+// If count is positive, the error is only served for that many requests; otherwise it's served until ClearError is called.
+func (s *Server) SetError(path string, status int, message string, count int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	remaining := count
+	if remaining <= 0 {
+		remaining = -1
+	}
+	s.errors[path] = &injectedError{
+		status:    status,
+		message:   message,
+		remaining: remaining,
+	}
+}
+
+// Stop serving an injected error for a path
+func (s *Server) ClearError(path string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.errors, path)
+}
+
+// Get the number of requests made to a path
+func (s *Server) RequestCount(path string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.requests[path]
+}
+
+// Set the sync status
+func (s *Server) SetSyncStatus(status SyncStatus) {
+	s.SetData(client.RequestSyncStatusPath, map[string]any{
+		"is_syncing":    status.IsSyncing,
+		"head_slot":     strconv.FormatUint(status.HeadSlot, 10),
+		"sync_distance": strconv.FormatUint(status.SyncDistance, 10),
+		"is_optimistic": status.IsOptimistic,
+		"el_offline":    status.ElOffline,
+	})
+}
+
+// Set a spec value, such as "SLOTS_PER_EPOCH"
+func (s *Server) SetSpec(key string, value string) {
+	s.lock.Lock()
+	s.spec[key] = value
+	s.lock.Unlock()
+	s.setSpecResponse()
+}
+
+// Update the canned spec response from the current spec values
+func (s *Server) setSpecResponse() {
+	s.lock.Lock()
+	spec := make(map[string]string, len(s.spec))
+	for key, value := range s.spec {
+		spec[key] = value
+	}
+	s.lock.Unlock()
+	s.SetData(client.RequestEth2ConfigPath, spec)
+}
+
+// Set the genesis data
+func (s *Server) SetGenesis(genesis Genesis) {
+	s.SetData(client.RequestGenesisPath, map[string]any{
+		"genesis_time":            strconv.FormatUint(genesis.Time, 10),
+		"genesis_fork_version":    hexutil.Encode(genesis.ForkVersion),
+		"genesis_validators_root": genesis.GenesisValidatorsRoot.Hex(),
+	})
+}
+
+// Set the validators for a state
+func (s *Server) SetValidators(stateId string, validators []Validator) {
+	data := make([]map[string]any, len(validators))
+	for i, validator := range validators {
+		data[i] = map[string]any{
+			"index":   strconv.FormatUint(validator.Index, 10),
+			"balance": strconv.FormatUint(validator.Balance, 10),
+			"status":  validator.Status,
+			"validator": map[string]any{
+				"pubkey":                       hexutil.Encode(validator.Pubkey[:]),
+				"withdrawal_credentials":       validator.WithdrawalCredentials.Hex(),
+				"effective_balance":            strconv.FormatUint(validator.EffectiveBalance, 10),
+				"slashed":                      validator.Slashed,
+				"activation_eligibility_epoch": strconv.FormatUint(validator.ActivationEligibilityEpoch, 10),
+				"activation_epoch":             strconv.FormatUint(validator.ActivationEpoch, 10),
+				"exit_epoch":                   strconv.FormatUint(validator.ExitEpoch, 10),
+				"withdrawable_epoch":           strconv.FormatUint(validator.WithdrawableEpoch, 10),
+			},
+		}
+	}
+	s.SetData(fmt.Sprintf(client.RequestValidatorsPath, stateId), data)
+}
+
+// Set the beacon committees for a state
+func (s *Server) SetCommittees(stateId string, committees []Committee) {
+	data := make([]map[string]any, len(committees))
+	for i, committee := range committees {
+		validators := make([]string, len(committee.Validators))
+		for j, index := range committee.Validators {
+			validators[j] = strconv.FormatUint(index, 10)
+		}
+		data[i] = map[string]any{
+			"index":      strconv.FormatUint(committee.Index, 10),
+			"slot":       strconv.FormatUint(committee.Slot, 10),
+			"validators": validators,
+		}
+	}
+	s.SetData(fmt.Sprintf(client.RequestCommitteePath, stateId), data)
+}
+
+// Set the beacon block for a block ID, such as a slot number or "head"
+func (s *Server) SetBlock(blockId string, block Block) {
+	graffiti := make([]byte, client.GraffitiLength)
+	copy(graffiti, block.Graffiti)
+	body := map[string]any{
+		"eth1_data": map[string]any{
+			"deposit_root":  common.Hash{}.Hex(),
+			"deposit_count": "0",
+			"block_hash":    common.Hash{}.Hex(),
+		},
+		"graffiti":     hexutil.Encode(graffiti),
+		"attestations": []any{},
+	}
+	if block.ExecutionBlockNumber != 0 {
+		body["execution_payload"] = map[string]any{
+			"fee_recipient":    block.FeeRecipient.Hex(),
+			"block_number":     strconv.FormatUint(block.ExecutionBlockNumber, 10),
+			"gas_limit":        "30000000",
+			"gas_used":         "0",
+			"timestamp":        "0",
+			"base_fee_per_gas": "0",
+			"block_hash":       common.Hash{}.Hex(),
+			"transactions":     []any{},
+			"withdrawals":      []any{},
+		}
+	}
+	version := block.Version
+	if version == "" {
+		version = "deneb"
+	}
+	encoded, err := json.Marshal(map[string]any{
+		"version": version,
+		"data": map[string]any{
+			"message": map[string]any{
+				"slot":           strconv.FormatUint(block.Slot, 10),
+				"proposer_index": strconv.FormatUint(block.ProposerIndex, 10),
+				"body":           body,
+			},
+		},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("error encoding canned block %s: %s", blockId, err.Error()))
+	}
+	s.SetResponse(fmt.Sprintf(client.RequestBeaconBlockPath, blockId), Response{
+		Status: http.StatusOK,
+		Header: http.Header{client.ConsensusVersionHeader: []string{version}},
+		Body:   encoded,
+	})
+}
@@ -65,7 +65,43 @@ func (c *CommitteesResponse) Validators(idx int) []string {
 	return c.Data[idx].Validators
 }
 
+// The position of a validator within the committees
+type committeePosition struct {
+	committee int
+	position  int
+}
+
+// Find the committee the validator with the provided index is in, and its position within that committee.
+// The first lookup builds an index over all of the committees, so later lookups don't need to scan them.
+func (c *CommitteesResponse) FindValidator(index string) (slot uint64, committeeIndex uint64, positionInCommittee int, found bool) {
+	if c.positions == nil {
+		count := 0
+		for _, committee := range c.Data {
+			count += len(committee.Validators)
+		}
+		c.positions = make(map[string]committeePosition, count)
+		for i, committee := range c.Data {
+			for j, validator := range committee.Validators {
+				c.positions[validator] = committeePosition{
+					committee: i,
+					position:  j,
+				}
+			}
+		}
+	}
+
+	position, exists := c.positions[index]
+	if !exists {
+		return 0, 0, 0, false
+	}
+	committee := c.Data[position.committee]
+	return uint64(committee.Slot), uint64(committee.Index), position.position, true
+}
+
 func (c *CommitteesResponse) Release() {
+	// The index refers to the pooled slices, so it's dropped with them
+	c.positions = nil
+
 	for _, committee := range c.Data {
 		// Reset the slice length to 0 (capacity stays the same)
 		committee.Validators = committee.Validators[:0]
@@ -0,0 +1,57 @@
+package client_test
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+func TestCommitteesDecode(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	bc := client.NewStandardHttpClient(server.URL)
+
+	// Decode a few times, so later decodes reuse the pooled decoder and validator slices released by earlier ones
+	for round := uint64(0); round < 3; round++ {
+		expected := []clienttest.Committee{
+			{Index: 0, Slot: 64 + round, Validators: []uint64{100*round + 10, 100*round + 11, 100*round + 12}},
+			{Index: 1, Slot: 64 + round, Validators: []uint64{100*round + 20, 100*round + 21}},
+			{Index: 0, Slot: 65 + round, Validators: []uint64{100*round + 30}},
+		}
+		server.SetCommittees("head", expected)
+
+		committees, err := bc.GetCommittees(context.Background(), "head", nil, nil, nil)
+		if err != nil {
+			t.Fatalf("round %d: error getting committees: %s", round, err.Error())
+		}
+		if committees.Count() != len(expected) {
+			t.Fatalf("round %d: expected %d committees, got %d", round, len(expected), committees.Count())
+		}
+		for i, committee := range expected {
+			if committees.Index(i) != committee.Index || committees.Slot(i) != committee.Slot {
+				t.Errorf("round %d, committee %d: expected index %d slot %d, got index %d slot %d", round, i, committee.Index, committee.Slot, committees.Index(i), committees.Slot(i))
+			}
+			validators := make([]string, len(committee.Validators))
+			for j, index := range committee.Validators {
+				validators[j] = strconv.FormatUint(index, 10)
+			}
+			if !reflect.DeepEqual(committees.Validators(i), validators) {
+				t.Errorf("round %d, committee %d: expected validators %v, got %v", round, i, validators, committees.Validators(i))
+			}
+		}
+
+		slot, committeeIndex, position, found := committees.FindValidator(strconv.FormatUint(100*round+21, 10))
+		if !found || slot != 64+round || committeeIndex != 1 || position != 1 {
+			t.Errorf("round %d: expected validator %d at slot %d committee 1 position 1, got slot %d committee %d position %d (found: %t)", round, 100*round+21, 64+round, slot, committeeIndex, position, found)
+		}
+		if _, _, _, found := committees.FindValidator("99"); found {
+			t.Errorf("round %d: found a validator that isn't in any committee", round)
+		}
+
+		committees.Release()
+	}
+}
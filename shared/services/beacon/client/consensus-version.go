@@ -0,0 +1,91 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// The consensus forks, in order
+var consensusForks = []string{"phase0", "altair", "bellatrix", "capella", "deneb", "electra"}
+
+// Get the position of a fork in the fork order.
+// Unknown forks are assumed to be newer than all of the known ones, so they're checked against the latest known fork's fields.
+func forkPosition(version string) int {
+	version = strings.ToLower(version)
+	for i, fork := range consensusForks {
+		if fork == version {
+			return i
+		}
+	}
+	return len(consensusForks)
+}
+
+// Only the presence of each fork-specific field is decoded
+type blockVersionFields struct {
+	Version string `json:"version"`
+	Data    struct {
+		Message struct {
+			Body struct {
+				SyncAggregate      *json.RawMessage `json:"sync_aggregate"`
+				BlobKzgCommitments *json.RawMessage `json:"blob_kzg_commitments"`
+				ExecutionRequests  *json.RawMessage `json:"execution_requests"`
+				ExecutionPayload   *struct {
+					Withdrawals   *json.RawMessage `json:"withdrawals"`
+					BlobGasUsed   *json.RawMessage `json:"blob_gas_used"`
+					ExcessBlobGas *json.RawMessage `json:"excess_blob_gas"`
+				} `json:"execution_payload"`
+			} `json:"body"`
+		} `json:"message"`
+	} `json:"data"`
+}
+
+// Check that a beacon block response has the fields of the fork named by its consensus version, and none from later forks.
+// The version is taken from the header, falling back to the body's version field if the header is missing.
+func checkBlockVersion(headerVersion string, body []byte) error {
+	var fields blockVersionFields
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return fmt.Errorf("error decoding block version fields: %w", err)
+	}
+
+	// Get the version
+	version := headerVersion
+	if version == "" {
+		version = fields.Version
+	} else if fields.Version != "" && !strings.EqualFold(fields.Version, headerVersion) {
+		return fmt.Errorf("%w: %s header is '%s' but the body's version is '%s'", beacon.ErrConsensusVersionMismatch, ConsensusVersionHeader, headerVersion, fields.Version)
+	}
+	if version == "" {
+		return fmt.Errorf("%w: the response does not have a consensus version", beacon.ErrConsensusVersionMismatch)
+	}
+	position := forkPosition(version)
+
+	// Check each fork-specific field
+	blockBody := fields.Data.Message.Body
+	payload := blockBody.ExecutionPayload
+	checks := []struct {
+		name    string
+		fork    string
+		present bool
+	}{
+		{"sync_aggregate", "altair", blockBody.SyncAggregate != nil},
+		{"execution_payload", "bellatrix", payload != nil},
+		{"execution_payload.withdrawals", "capella", payload != nil && payload.Withdrawals != nil},
+		{"blob_kzg_commitments", "deneb", blockBody.BlobKzgCommitments != nil},
+		{"execution_payload.blob_gas_used", "deneb", payload != nil && payload.BlobGasUsed != nil},
+		{"execution_payload.excess_blob_gas", "deneb", payload != nil && payload.ExcessBlobGas != nil},
+		{"execution_requests", "electra", blockBody.ExecutionRequests != nil},
+	}
+	for _, check := range checks {
+		expected := position >= forkPosition(check.fork)
+		if check.present && !expected {
+			return fmt.Errorf("%w: %s block has the %s field, which was added in %s", beacon.ErrConsensusVersionMismatch, version, check.name, check.fork)
+		}
+		if !check.present && expected {
+			return fmt.Errorf("%w: %s block is missing the %s field, which is required from %s", beacon.ErrConsensusVersionMismatch, version, check.name, check.fork)
+		}
+	}
+	return nil
+}
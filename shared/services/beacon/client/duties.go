@@ -0,0 +1,36 @@
+package client
+
+import (
+	"encoding/hex"
+
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Get the sync duty of the validator with the provided index, if it's in the sync committee
+func (r *SyncDutiesResponse) ForValidator(index string) (*SyncDuty, bool) {
+	r.buildIndex()
+	duty, exists := r.index[index]
+	return duty, exists
+}
+
+// Get the sync duty of the validator with the provided pubkey, if it's in the sync committee
+func (r *SyncDutiesResponse) ForPubkey(pubkey []byte) (*SyncDuty, bool) {
+	r.buildIndex()
+	duty, exists := r.index[hexutil.AddPrefix(hex.EncodeToString(pubkey))]
+	return duty, exists
+}
+
+// Build the lookup index over Data, keyed by both validator index and 0x-prefixed pubkey.
+// The index is rebuilt if Data has been replaced since it was last built.
+func (r *SyncDutiesResponse) buildIndex() {
+	if r.index != nil && len(r.Data) == len(r.indexedData) && (len(r.Data) == 0 || &r.Data[0] == &r.indexedData[0]) {
+		return
+	}
+	r.index = make(map[string]*SyncDuty, len(r.Data)*2)
+	for i := range r.Data {
+		duty := &r.Data[i]
+		r.index[duty.ValidatorIndex] = duty
+		r.index[hexutil.AddPrefix(hex.EncodeToString(duty.Pubkey))] = duty
+	}
+	r.indexedData = r.Data
+}
@@ -0,0 +1,276 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// Reconnection settings for the event stream
+const (
+	eventStreamInitialBackoff = time.Second
+	eventStreamMaxBackoff     = 30 * time.Second
+)
+
+// Event types
+type HeadEvent struct {
+	Slot                      uinteger  `json:"slot"`
+	Block                     byteArray `json:"block"`
+	State                     byteArray `json:"state"`
+	EpochTransition           bool      `json:"epoch_transition"`
+	PreviousDutyDependentRoot byteArray `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  byteArray `json:"current_duty_dependent_root"`
+	ExecutionOptimistic       bool      `json:"execution_optimistic"`
+}
+type BlockEvent struct {
+	Slot                uinteger  `json:"slot"`
+	Block               byteArray `json:"block"`
+	ExecutionOptimistic bool      `json:"execution_optimistic"`
+}
+type ChainReorgEvent struct {
+	Slot         uinteger  `json:"slot"`
+	Depth        uinteger  `json:"depth"`
+	OldHeadBlock byteArray `json:"old_head_block"`
+	NewHeadBlock byteArray `json:"new_head_block"`
+	Epoch        uinteger  `json:"epoch"`
+}
+type FinalizedCheckpointEvent struct {
+	Block               byteArray `json:"block"`
+	State               byteArray `json:"state"`
+	Epoch               uinteger  `json:"epoch"`
+	ExecutionOptimistic bool      `json:"execution_optimistic"`
+}
+
+// Subscribe to the Beacon node's event stream for the provided topics.
+// The stream is reconnected with exponential backoff if it drops. The returned channel is closed once ctx is cancelled.
+func (c *StandardHttpClient) Events(ctx context.Context, topics []string) (<-chan beacon.Event, error) {
+
+	// Check the topics
+	for _, topic := range topics {
+		switch topic {
+		case beacon.EventTopic_Head,
+			beacon.EventTopic_Block,
+			beacon.EventTopic_Attestation,
+			beacon.EventTopic_VoluntaryExit,
+			beacon.EventTopic_FinalizedCheckpoint,
+			beacon.EventTopic_ChainReorg:
+		default:
+			return nil, fmt.Errorf("Unknown event topic '%s'", topic)
+		}
+	}
+
+	// Open the initial connection so connection failures are reported to the caller
+	requestPath := fmt.Sprintf(RequestEventsPath, strings.Join(topics, ","))
+	stream, err := c.openEventStream(ctx, requestPath)
+	if err != nil {
+		return nil, fmt.Errorf("Could not subscribe to events: %w", err)
+	}
+
+	events := make(chan beacon.Event)
+	go c.runEventStream(ctx, requestPath, stream, events)
+	return events, nil
+
+}
+
+// Open a connection to the event stream
+func (c *StandardHttpClient) openEventStream(ctx context.Context, requestPath string) (io.ReadCloser, error) {
+	response, err := c.sendGetRequest(ctx, requestPath, RequestEventStreamContentType)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		return nil, fmt.Errorf("HTTP status %d; response body: '%s'", response.StatusCode, string(body))
+	}
+	return response.Body, nil
+}
+
+// Read events from the stream until ctx is cancelled, reconnecting whenever the stream drops
+func (c *StandardHttpClient) runEventStream(ctx context.Context, requestPath string, stream io.ReadCloser, events chan<- beacon.Event) {
+	defer close(events)
+
+	backoff := eventStreamInitialBackoff
+	for {
+		if stream != nil {
+			c.readEventStream(ctx, stream, events)
+			_ = stream.Close()
+			stream = nil
+		}
+
+		// Wait before reconnecting
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		var err error
+		stream, err = c.openEventStream(ctx, requestPath)
+		if err != nil {
+			backoff *= 2
+			if backoff > eventStreamMaxBackoff {
+				backoff = eventStreamMaxBackoff
+			}
+			continue
+		}
+		backoff = eventStreamInitialBackoff
+	}
+}
+
+// Parse server-sent events from the stream and send them to the events channel, until the stream ends or ctx is cancelled
+func (c *StandardHttpClient) readEventStream(ctx context.Context, stream io.Reader, events chan<- beacon.Event) {
+	reader := bufio.NewReader(stream)
+	var topic string
+	var data strings.Builder
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		// A blank line dispatches the event
+		case line == "":
+			if topic != "" && data.Len() > 0 {
+				// Events that can't be decoded are still sent, carrying the error, so they don't vanish without a trace
+				event, err := decodeEvent(topic, []byte(data.String()))
+				if err != nil {
+					if c.logger != nil {
+						c.logger.Printlnf("[Beacon] Received a %s event that couldn't be decoded: %s", topic, err.Error())
+					}
+					event = beacon.Event{
+						Topic: topic,
+						Err:   err,
+					}
+				}
+				c.observeEvent(event)
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			topic = ""
+			data.Reset()
+
+		// Comments are used as keepalives
+		case strings.HasPrefix(line, ":"):
+
+		case strings.HasPrefix(line, "event:"):
+			topic = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+}
+
+// Decode the payload of an event
+func decodeEvent(topic string, data []byte) (beacon.Event, error) {
+	event := beacon.Event{
+		Topic: topic,
+	}
+
+	switch topic {
+	case beacon.EventTopic_Head:
+		var head HeadEvent
+		if err := decodeResponse(data, &head); err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode head event: %w", err)
+		}
+		event.Head = &beacon.HeadEvent{
+			Slot:                      uint64(head.Slot),
+			Block:                     common.BytesToHash(head.Block),
+			State:                     common.BytesToHash(head.State),
+			EpochTransition:           head.EpochTransition,
+			PreviousDutyDependentRoot: common.BytesToHash(head.PreviousDutyDependentRoot),
+			CurrentDutyDependentRoot:  common.BytesToHash(head.CurrentDutyDependentRoot),
+			ExecutionOptimistic:       head.ExecutionOptimistic,
+		}
+
+	case beacon.EventTopic_Block:
+		var block BlockEvent
+		if err := decodeResponse(data, &block); err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode block event: %w", err)
+		}
+		event.Block = &beacon.BlockEvent{
+			Slot:                uint64(block.Slot),
+			Block:               common.BytesToHash(block.Block),
+			ExecutionOptimistic: block.ExecutionOptimistic,
+		}
+
+	case beacon.EventTopic_Attestation:
+		var attestation Attestation
+		if err := decodeResponse(data, &attestation); err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode attestation event: %w", err)
+		}
+		info, err := attestation.toBeaconInfo()
+		if err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode attestation event: %w", err)
+		}
+		event.Attestation = &info
+
+	case beacon.EventTopic_VoluntaryExit:
+		var exit VoluntaryExitRequest
+		if err := decodeResponse(data, &exit); err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode voluntary exit event: %w", err)
+		}
+		voluntaryExit := exit.toBeacon()
+		event.VoluntaryExit = &voluntaryExit
+
+	case beacon.EventTopic_FinalizedCheckpoint:
+		var checkpoint FinalizedCheckpointEvent
+		if err := decodeResponse(data, &checkpoint); err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode finalized checkpoint event: %w", err)
+		}
+		event.FinalizedCheckpoint = &beacon.FinalizedCheckpointEvent{
+			Block:               common.BytesToHash(checkpoint.Block),
+			State:               common.BytesToHash(checkpoint.State),
+			Epoch:               uint64(checkpoint.Epoch),
+			ExecutionOptimistic: checkpoint.ExecutionOptimistic,
+		}
+
+	case beacon.EventTopic_ChainReorg:
+		var reorg ChainReorgEvent
+		if err := decodeResponse(data, &reorg); err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode chain reorg event: %w", err)
+		}
+		event.ChainReorg = &beacon.ChainReorgEvent{
+			Slot:         uint64(reorg.Slot),
+			Depth:        uint64(reorg.Depth),
+			OldHeadBlock: common.BytesToHash(reorg.OldHeadBlock),
+			NewHeadBlock: common.BytesToHash(reorg.NewHeadBlock),
+			Epoch:        uint64(reorg.Epoch),
+		}
+	}
+
+	return event, nil
+}
+
+// Update the client's own state from an event before it's passed on
+func (c *StandardHttpClient) observeEvent(event beacon.Event) {
+	if event.FinalizedCheckpoint != nil {
+		c.setFinalizedEpoch(event.FinalizedCheckpoint.Epoch)
+	}
+	if event.ChainReorg != nil && c.blockCache != nil {
+		c.blockCache.invalidateFrom(event.ChainReorg.FirstAffectedSlot())
+	}
+
+	// The affected slots of a reorg that couldn't be decoded are unknown, so nothing cached can be trusted
+	if event.Err != nil && event.Topic == beacon.EventTopic_ChainReorg && c.blockCache != nil {
+		c.blockCache.invalidateFrom(0)
+	}
+}
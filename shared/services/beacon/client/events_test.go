@@ -0,0 +1,183 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+const (
+	oldHeadBlock = "0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf"
+	newHeadBlock = "0x76262e91970d375a19bfe8a867288d7b9cde43c8635f598d93d39d041706fc76"
+)
+
+func TestChainReorgEvent(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+
+	// A raw event as sent by the Beacon node, with a keepalive comment before it
+	stream := ":\n" +
+		"event: chain_reorg\n" +
+		`data: {"slot":"200","depth":"50","old_head_block":"` + oldHeadBlock + `","new_head_block":"` + newHeadBlock + `","old_head_state":"0x0000000000000000000000000000000000000000000000000000000000000000","new_head_state":"0x0000000000000000000000000000000000000000000000000000000000000000","epoch":"6","execution_optimistic":false}` + "\n" +
+		"\n"
+	server.SetResponse("/eth/v1/events", clienttest.Response{
+		Status: http.StatusOK,
+		Header: http.Header{"Content-Type": []string{client.RequestEventStreamContentType}},
+		Body:   []byte(stream),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bc := client.NewStandardHttpClient(server.URL)
+	events, err := bc.Events(ctx, []string{beacon.EventTopic_ChainReorg})
+	if err != nil {
+		t.Fatalf("error subscribing to events: %s", err.Error())
+	}
+
+	var event beacon.Event
+	select {
+	case event = <-events:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the chain reorg event")
+	}
+
+	if event.Topic != beacon.EventTopic_ChainReorg {
+		t.Fatalf("expected topic %s, got %s", beacon.EventTopic_ChainReorg, event.Topic)
+	}
+	reorg := event.ChainReorg
+	if reorg == nil {
+		t.Fatal("chain reorg event wasn't decoded")
+	}
+	if reorg.Slot != 200 {
+		t.Errorf("expected slot 200, got %d", reorg.Slot)
+	}
+	if reorg.Depth != 50 {
+		t.Errorf("expected depth 50, got %d", reorg.Depth)
+	}
+	if reorg.OldHeadBlock != common.HexToHash(oldHeadBlock) {
+		t.Errorf("expected old head block %s, got %s", oldHeadBlock, reorg.OldHeadBlock.Hex())
+	}
+	if reorg.NewHeadBlock != common.HexToHash(newHeadBlock) {
+		t.Errorf("expected new head block %s, got %s", newHeadBlock, reorg.NewHeadBlock.Hex())
+	}
+	if reorg.Epoch != 6 {
+		t.Errorf("expected epoch 6, got %d", reorg.Epoch)
+	}
+	if reorg.FirstAffectedSlot() != 150 {
+		t.Errorf("expected first affected slot 150, got %d", reorg.FirstAffectedSlot())
+	}
+}
+
+func TestChainReorgFirstAffectedSlot(t *testing.T) {
+	tests := []struct {
+		slot     uint64
+		depth    uint64
+		expected uint64
+	}{
+		{slot: 200, depth: 1, expected: 199},
+		{slot: 200, depth: 0, expected: 200},
+		{slot: 3, depth: 3, expected: 0},
+		{slot: 3, depth: 10, expected: 0},
+	}
+	for _, test := range tests {
+		reorg := beacon.ChainReorgEvent{Slot: test.slot, Depth: test.depth}
+		if slot := reorg.FirstAffectedSlot(); slot != test.expected {
+			t.Errorf("slot %d depth %d: expected first affected slot %d, got %d", test.slot, test.depth, test.expected, slot)
+		}
+	}
+}
+
+// Logger that records what it's given
+type recordingLogger struct {
+	lock  sync.Mutex
+	lines []string
+}
+
+func (l *recordingLogger) Printlnf(format string, v ...interface{}) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestUndecodableEvent(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	server.SetBlock("100", clienttest.Block{Slot: 100})
+
+	// A reorg whose slot isn't a number, followed by one that's fine
+	stream := "event: chain_reorg\n" +
+		`data: {"slot":"two hundred","depth":"50","old_head_block":"` + oldHeadBlock + `","new_head_block":"` + newHeadBlock + `","epoch":"6"}` + "\n" +
+		"\n" +
+		"event: chain_reorg\n" +
+		`data: {"slot":"200","depth":"50","old_head_block":"` + oldHeadBlock + `","new_head_block":"` + newHeadBlock + `","epoch":"6"}` + "\n" +
+		"\n"
+	server.SetResponse("/eth/v1/events", clienttest.Response{
+		Status: http.StatusOK,
+		Header: http.Header{"Content-Type": []string{client.RequestEventStreamContentType}},
+		Body:   []byte(stream),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger := &recordingLogger{}
+	bc := client.NewStandardHttpClient(server.URL, client.WithLogger(logger), client.WithBlockCache(16, true))
+
+	// Cache a block from before the reorg's affected slots
+	for i := 0; i < 2; i++ {
+		if _, _, err := bc.GetEth1DataForEth2Block(ctx, "100"); err != nil {
+			t.Fatalf("error getting block: %s", err.Error())
+		}
+	}
+	if hits, _ := bc.BlockCacheStats(); hits != 1 {
+		t.Fatalf("expected the block to be cached, got %d hits", hits)
+	}
+
+	events, err := bc.Events(ctx, []string{beacon.EventTopic_ChainReorg})
+	if err != nil {
+		t.Fatalf("error subscribing to events: %s", err.Error())
+	}
+	receive := func() beacon.Event {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an event")
+			return beacon.Event{}
+		}
+	}
+
+	event := receive()
+	if event.Topic != beacon.EventTopic_ChainReorg || event.Err == nil || event.ChainReorg != nil {
+		t.Fatalf("expected a chain reorg event carrying an error, got topic %s, error %v, reorg %v", event.Topic, event.Err, event.ChainReorg)
+	}
+	logger.lock.Lock()
+	logged := strings.Join(logger.lines, "\n")
+	logger.lock.Unlock()
+	if !strings.Contains(logged, "chain_reorg event that couldn't be decoded") {
+		t.Errorf("expected the decoding error to be logged, got:\n%s", logged)
+	}
+
+	// The affected slots are unknown, so the whole cache should have been dropped
+	if _, _, err := bc.GetEth1DataForEth2Block(ctx, "100"); err != nil {
+		t.Fatalf("error getting block: %s", err.Error())
+	}
+	if hits, misses := bc.BlockCacheStats(); hits != 1 || misses != 2 {
+		t.Errorf("expected the cache to be dropped, got %d hits and %d misses", hits, misses)
+	}
+
+	// Later events are still delivered
+	event = receive()
+	if event.Err != nil || event.ChainReorg == nil || event.ChainReorg.Slot != 200 {
+		t.Errorf("expected the next chain reorg event to be decoded, got error %v, reorg %v", event.Err, event.ChainReorg)
+	}
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Wraps a gzip-encoded response body so closing it closes the underlying connection as well
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	gzErr := b.Reader.Close()
+	if err := b.body.Close(); err != nil {
+		return err
+	}
+	return gzErr
+}
+
+// Replace the body of a gzip-encoded response with one that decompresses it on the fly.
+// The response's headers are updated to describe the decompressed body, so the (compressed) Content-Length is dropped.
+func decompressResponse(response *http.Response) error {
+	if !strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+
+	reader, err := gzip.NewReader(response.Body)
+	if errors.Is(err, io.EOF) {
+		// Empty body, nothing to decompress
+		_ = response.Body.Close()
+		response.Body = http.NoBody
+	} else if err != nil {
+		return fmt.Errorf("error reading gzip response body: %w", err)
+	} else {
+		response.Body = &gzipBody{
+			Reader: reader,
+			body:   response.Body,
+		}
+	}
+
+	response.Header.Del("Content-Encoding")
+	response.Header.Del("Content-Length")
+	response.ContentLength = -1
+	response.Uncompressed = true
+	return nil
+}
@@ -0,0 +1,45 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+// Only 400s that say the epoch is out of range mean liveness can't be checked; anything else is bad input and has to surface as a normal error
+func TestGetValidatorLivenessEpochUnavailable(t *testing.T) {
+	tests := []struct {
+		name        string
+		message     string
+		unavailable bool
+	}{
+		{name: "Lighthouse epoch", message: "BAD_REQUEST: request epoch 100 is more than one epoch from the current epoch 1000", unavailable: true},
+		{name: "Lodestar epoch", message: "Request epoch 100 is more than one epoch before or after the current epoch 1000", unavailable: true},
+		{name: "Prysm future epoch", message: "Requested epoch cannot be in the future", unavailable: true},
+		{name: "malformed index", message: "BAD_REQUEST: invalid validator index: foo", unavailable: false},
+		{name: "malformed body", message: "BAD_REQUEST: failed to parse request body: expected a JSON array", unavailable: false},
+		{name: "malformed epoch", message: "BAD_REQUEST: invalid epoch: abc", unavailable: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := clienttest.NewServer()
+			defer server.Close()
+			server.SetError(fmt.Sprintf(client.RequestValidatorLivenessPath, "100"), http.StatusBadRequest, test.message, 0)
+
+			bc := client.NewStandardHttpClient(server.URL)
+			_, err := bc.GetValidatorLiveness(context.Background(), 100, []string{"1", "2"})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if errors.Is(err, beacon.ErrLivenessEpochUnavailable) != test.unavailable {
+				t.Errorf("expected errors.Is(err, ErrLivenessEpochUnavailable) to be %t, got error: %s", test.unavailable, err.Error())
+			}
+		})
+	}
+}
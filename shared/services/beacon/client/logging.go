@@ -0,0 +1,83 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Destination for the client's debug logging; *log.ColorLogger satisfies it
+type Logger interface {
+	Printlnf(format string, v ...interface{})
+}
+
+// Log every request to the Beacon node as it starts and finishes, along with any retries.
+// URLs are logged with credentials and secret-looking query parameters redacted.
+func WithLogger(logger Logger) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		c.logger = logger
+	}
+}
+
+// Query parameters whose values are redacted from logged URLs, matched by substring
+var secretQueryParams = []string{"key", "token", "secret", "auth", "password"}
+
+// Get a URL that's safe to log
+func redactUrl(u *url.URL) string {
+	redacted := *u
+	if redacted.RawQuery != "" {
+		query := redacted.Query()
+		for name := range query {
+			lowerName := strings.ToLower(name)
+			for _, secret := range secretQueryParams {
+				if strings.Contains(lowerName, secret) {
+					query.Set(name, "xxxxx")
+					break
+				}
+			}
+		}
+		redacted.RawQuery = query.Encode()
+	}
+	return redacted.Redacted()
+}
+
+// Log the start of a request
+func (c *StandardHttpClient) logRequestStart(request *http.Request) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.Printlnf("[Beacon] %s %s started (request %s)", request.Method, redactUrl(request.URL), request.Header.Get(RequestIdHeader))
+}
+
+// Log the end of a request
+func (c *StandardHttpClient) logRequestEnd(request *http.Request, response *http.Response, start time.Time, err error) {
+	if c.logger == nil {
+		return
+	}
+	if err != nil {
+		c.logger.Printlnf("[Beacon] %s %s failed after %s (request %s): %s", request.Method, redactUrl(request.URL), time.Since(start), request.Header.Get(RequestIdHeader), err.Error())
+		return
+	}
+	c.logger.Printlnf("[Beacon] %s %s returned HTTP %d after %s (request %s)", request.Method, redactUrl(request.URL), response.StatusCode, time.Since(start), request.Header.Get(RequestIdHeader))
+}
+
+// Log that a request is about to be retried
+func (c *StandardHttpClient) logRetry(requestPath string, attempt int, delay time.Duration, response *http.Response, err error) {
+	if c.logger == nil {
+		return
+	}
+	reason := "unknown error"
+	if err != nil {
+		reason = err.Error()
+	} else if response != nil {
+		reason = fmt.Sprintf("HTTP status %d", response.StatusCode)
+	}
+	u, parseErr := url.Parse(c.providerAddress + requestPath)
+	target := requestPath
+	if parseErr == nil {
+		target = redactUrl(u)
+	}
+	c.logger.Printlnf("[Beacon] GET %s attempt %d of %d failed (%s), retrying in %s", target, attempt, c.retry.maxAttempts, reason, delay)
+}
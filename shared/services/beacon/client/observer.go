@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Details of a completed request to the Beacon node
+type RequestInfo struct {
+	MethodName   string // The beacon.Client method that made the request, if there was one
+	RequestID    string // The X-Request-ID sent with the request; each retry attempt gets its own
+	HttpMethod   string
+	Path         string
+	Status       int // Zero if the request failed before a response was received
+	Duration     time.Duration
+	ResponseSize int64
+	Err          error
+}
+
+// Call observer after every request to the Beacon node, including failed ones, once its response has been read.
+// The observer is called synchronously, so it should return quickly.
+func WithRequestObserver(observer func(RequestInfo)) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		c.requestObserver = observer
+	}
+}
+
+type methodNameKey struct{}
+
+// Attach the name of the calling method to the context, so requests can be attributed to it
+func withMethodName(ctx context.Context, methodName string) context.Context {
+	return context.WithValue(ctx, methodNameKey{}, methodName)
+}
+
+// Get the name of the calling method from the context, if it has one
+func methodNameFrom(ctx context.Context) string {
+	methodName, _ := ctx.Value(methodNameKey{}).(string)
+	return methodName
+}
+
+// Report a request to the observer if there is one. Failed requests are reported immediately; successful ones are
+// reported when their body is closed, so the duration and size cover the whole response.
+func (c *StandardHttpClient) observeRequest(request *http.Request, response *http.Response, start time.Time, err error) {
+	if c.requestObserver == nil {
+		return
+	}
+	info := RequestInfo{
+		MethodName: methodNameFrom(request.Context()),
+		RequestID:  request.Header.Get(RequestIdHeader),
+		HttpMethod: request.Method,
+		Path:       request.URL.Path,
+		Err:        err,
+	}
+	if response == nil {
+		info.Duration = time.Since(start)
+		c.requestObserver(info)
+		return
+	}
+
+	// Event streams stay open indefinitely, so report them as soon as they're connected
+	info.Status = response.StatusCode
+	if request.Header.Get("Accept") == RequestEventStreamContentType {
+		info.Duration = time.Since(start)
+		c.requestObserver(info)
+		return
+	}
+	response.Body = &observedBody{
+		ReadCloser: response.Body,
+		info:       info,
+		start:      start,
+		observer:   c.requestObserver,
+	}
+}
+
+// A response body that reports the request to the observer once it's been closed
+type observedBody struct {
+	io.ReadCloser
+	info     RequestInfo
+	start    time.Time
+	observer func(RequestInfo)
+	once     sync.Once
+}
+
+func (b *observedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.info.ResponseSize += int64(n)
+	if err != nil && err != io.EOF {
+		b.info.Err = err
+	}
+	return n, err
+}
+
+func (b *observedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.info.Duration = time.Since(b.start)
+		b.observer(b.info)
+	})
+	return err
+}
+
+// The raw body of a response from the Beacon node
+type RawResponse struct {
+	MethodName string
+	Path       string
+	Status     int
+	Body       []byte
+}
+
+// Pass the raw body of every buffered response to callback before it's decoded, so payloads that fail to decode can be captured.
+// The body is shared with the decoder and must not be modified. Streamed responses (such as committees and events) aren't captured.
+// This holds on to every response body until the callback returns, so it's meant for debugging rather than regular use.
+func WithRawResponses(callback func(RawResponse)) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		c.rawResponseCallback = callback
+	}
+}
+
+// Pass a response body to the raw response callback if there is one
+func (c *StandardHttpClient) captureRawResponse(ctx context.Context, requestPath string, status int, body []byte) {
+	if c.rawResponseCallback == nil {
+		return
+	}
+	c.rawResponseCallback(RawResponse{
+		MethodName: methodNameFrom(ctx),
+		Path:       requestPath,
+		Status:     status,
+		Body:       body,
+	})
+}
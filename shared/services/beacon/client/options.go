@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Option for configuring a StandardHttpClient
+type StandardHttpClientOption func(*StandardHttpClient)
+
+// Get the full validator set by requesting the Beacon state as SSZ from the debug states route, instead of the validators
+// route as JSON, which is substantially slower to decode. Only the validators and balances are read from the state.
+// Requests for specific validators still use the validators route. If the node doesn't serve the state as SSZ, the client
+// falls back to JSON.
+func WithSszValidators() StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		c.useSszValidators = true
+	}
+}
+
+// Retry idempotent GET requests that fail with a connection error, a 5xx response, or a 429, up to maxAttempts total attempts.
+// Attempts are spaced with exponential backoff starting at baseDelay, with jitter, except that a 429's Retry-After is waited out instead
+// if it's a minute or less. Other 4xx responses are never retried. Requests that are still rate limited fail with a *beacon.RateLimitedError.
+func WithRetry(maxAttempts int, baseDelay time.Duration) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		c.retry = retryPolicy{
+			maxAttempts: maxAttempts,
+			baseDelay:   baseDelay,
+		}
+	}
+}
+
+// Check versioned responses (currently beacon blocks) against the fork named by their consensus version before decoding them.
+// If a response has fields that its fork shouldn't have, or is missing fields that its fork requires, the request fails
+// with an error wrapping beacon.ErrConsensusVersionMismatch instead of silently decoding zero values.
+func WithStrictVersionChecks() StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		c.strictVersionChecks = true
+	}
+}
+
+// Send userAgent as the User-Agent of every request instead of DefaultUserAgent
+func WithUserAgent(userAgent string) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		c.userAgent = userAgent
+	}
+}
+
+// Send Authorization: Bearer <token> with every request, for nodes behind an authenticating proxy.
+// Headers are never logged or passed to the request observer, so the token stays out of debug output.
+func WithBearerToken(token string) StandardHttpClientOption {
+	return WithHeader("Authorization", "Bearer "+token)
+}
+
+// Send a custom header with every request. This is applied after the client's own headers, so it can override them.
+func WithHeader(key string, value string) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		if c.headers == nil {
+			c.headers = http.Header{}
+		}
+		c.headers.Set(key, value)
+	}
+}
+
+// Limit how long calls to the named beacon.Client method (e.g. "GetSyncStatus") can take.
+// This is applied on top of the deadline of the context passed to the method, so whichever expires first wins:
+// a method timeout can shorten a caller's deadline but never extend it. Long-lived event streams aren't affected.
+func WithMethodTimeout(methodName string, timeout time.Duration) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		if c.methodTimeouts == nil {
+			c.methodTimeouts = map[string]time.Duration{}
+		}
+		c.methodTimeouts[methodName] = timeout
+	}
+}
+
+// Get the context to use for a call to the named method, applying its configured timeout if it has one
+func (c *StandardHttpClient) methodContext(ctx context.Context, methodName string) (context.Context, context.CancelFunc) {
+	ctx = withMethodName(ctx, methodName)
+	timeout, exists := c.methodTimeouts[methodName]
+	if !exists {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
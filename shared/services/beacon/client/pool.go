@@ -0,0 +1,455 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Submit an attester slashing to the Beacon node's operation pool
+func (c *StandardHttpClient) SubmitAttesterSlashing(ctx context.Context, slashing beacon.AttesterSlashing) error {
+	ctx, cancel := c.methodContext(ctx, "SubmitAttesterSlashing")
+	defer cancel()
+	return c.postAttesterSlashing(ctx, AttesterSlashing{
+		Attestation1: indexedAttestationFromBeacon(slashing.Attestation1),
+		Attestation2: indexedAttestationFromBeacon(slashing.Attestation2),
+	})
+}
+
+// Submit a proposer slashing to the Beacon node's operation pool
+func (c *StandardHttpClient) SubmitProposerSlashing(ctx context.Context, slashing beacon.ProposerSlashing) error {
+	ctx, cancel := c.methodContext(ctx, "SubmitProposerSlashing")
+	defer cancel()
+	return c.postProposerSlashing(ctx, ProposerSlashing{
+		SignedHeader1: signedBeaconBlockHeaderFromBeacon(slashing.SignedHeader1),
+		SignedHeader2: signedBeaconBlockHeaderFromBeacon(slashing.SignedHeader2),
+	})
+}
+
+// Get the attester slashings in the Beacon node's operation pool
+func (c *StandardHttpClient) GetPoolAttesterSlashings(ctx context.Context) ([]beacon.AttesterSlashing, error) {
+	ctx, cancel := c.methodContext(ctx, "GetPoolAttesterSlashings")
+	defer cancel()
+	response, err := c.getAttesterSlashings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slashings := make([]beacon.AttesterSlashing, len(response.Data))
+	for i, slashing := range response.Data {
+		slashings[i] = beacon.AttesterSlashing{
+			Attestation1: slashing.Attestation1.toBeacon(),
+			Attestation2: slashing.Attestation2.toBeacon(),
+		}
+	}
+	return slashings, nil
+}
+
+// Get the proposer slashings in the Beacon node's operation pool
+func (c *StandardHttpClient) GetPoolProposerSlashings(ctx context.Context) ([]beacon.ProposerSlashing, error) {
+	ctx, cancel := c.methodContext(ctx, "GetPoolProposerSlashings")
+	defer cancel()
+	response, err := c.getProposerSlashings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slashings := make([]beacon.ProposerSlashing, len(response.Data))
+	for i, slashing := range response.Data {
+		slashings[i] = beacon.ProposerSlashing{
+			SignedHeader1: slashing.SignedHeader1.toBeacon(),
+			SignedHeader2: slashing.SignedHeader2.toBeacon(),
+		}
+	}
+	return slashings, nil
+}
+
+// Submit signed attestations to the Beacon node's operation pool for broadcasting.
+// If the node rejects some of them, the returned error is a *beacon.BatchSubmissionError keyed by position in the provided slice.
+func (c *StandardHttpClient) SubmitAttestations(ctx context.Context, attestations []beacon.Attestation) error {
+	ctx, cancel := c.methodContext(ctx, "SubmitAttestations")
+	defer cancel()
+	if len(attestations) == 0 {
+		return nil
+	}
+
+	requests := make([]Attestation, len(attestations))
+	for i, attestation := range attestations {
+		requests[i] = attestationFromBeacon(attestation)
+	}
+	return c.postAttestations(ctx, requests)
+}
+
+// Submit signed sync committee messages to the Beacon node's operation pool for broadcasting.
+// If the node rejects some of them, the returned error is a *beacon.BatchSubmissionError keyed by position in the provided slice.
+func (c *StandardHttpClient) SubmitSyncCommitteeMessages(ctx context.Context, messages []beacon.SyncCommitteeMessage) error {
+	ctx, cancel := c.methodContext(ctx, "SubmitSyncCommitteeMessages")
+	defer cancel()
+	if len(messages) == 0 {
+		return nil
+	}
+
+	requests := make([]SyncCommitteeMessageRequest, len(messages))
+	for i, message := range messages {
+		requests[i] = SyncCommitteeMessageRequest{
+			Slot:            uinteger(message.Slot),
+			BeaconBlockRoot: message.BeaconBlockRoot.Bytes(),
+			ValidatorIndex:  message.ValidatorIndex,
+			Signature:       message.Signature.Bytes(),
+		}
+	}
+	return c.postSyncCommitteeMessages(ctx, requests)
+}
+
+// Get the voluntary exits in the Beacon node's operation pool
+func (c *StandardHttpClient) GetPoolVoluntaryExits(ctx context.Context) ([]beacon.SignedVoluntaryExit, error) {
+	ctx, cancel := c.methodContext(ctx, "GetPoolVoluntaryExits")
+	defer cancel()
+	response, err := c.getVoluntaryExits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exits := make([]beacon.SignedVoluntaryExit, len(response.Data))
+	for i, exit := range response.Data {
+		exits[i] = exit.toBeacon()
+	}
+	return exits, nil
+}
+
+// Submit a batch of withdrawal credentials changes to the Beacon node's operation pool.
+// If the node rejects the batch as too large, the changes are submitted individually.
+// Rejected changes are reported in a *beacon.BatchSubmissionError, keyed by their position in changes.
+func (c *StandardHttpClient) SubmitBLSToExecutionChanges(ctx context.Context, changes []beacon.SignedBLSToExecutionChange) error {
+	ctx, cancel := c.methodContext(ctx, "SubmitBLSToExecutionChanges")
+	defer cancel()
+	if len(changes) == 0 {
+		return nil
+	}
+
+	requests := make([]BLSToExecutionChangeRequest, len(changes))
+	for i, change := range changes {
+		requests[i] = blsToExecutionChangeFromBeacon(change)
+	}
+
+	// Try submitting the whole batch at once
+	responseBody, status, err := c.postRequest(ctx, RequestWithdrawalCredentialsChangePath, requests)
+	if err != nil {
+		return fmt.Errorf("Could not broadcast withdrawal credentials changes: %w", err)
+	}
+	if status == http.StatusOK {
+		return nil
+	}
+	if status == http.StatusBadRequest {
+		if batchErr := parseBatchSubmissionError(responseBody, "Could not broadcast withdrawal credentials changes"); batchErr != nil {
+			return batchErr
+		}
+	}
+	if status != http.StatusRequestEntityTooLarge {
+		return fmt.Errorf("Could not broadcast withdrawal credentials changes: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+
+	// Fall back to submitting them one at a time
+	batchErr := &beacon.BatchSubmissionError{
+		Message:  "Could not broadcast withdrawal credentials changes",
+		Failures: map[int]string{},
+	}
+	for i, request := range requests {
+		if err := c.postWithdrawalCredentialsChange(ctx, request); err != nil {
+			batchErr.Failures[i] = err.Error()
+		}
+	}
+	if len(batchErr.Failures) > 0 {
+		return batchErr
+	}
+	return nil
+}
+
+// Get the withdrawal credentials changes in the Beacon node's operation pool
+func (c *StandardHttpClient) GetPoolBLSToExecutionChanges(ctx context.Context) ([]beacon.SignedBLSToExecutionChange, error) {
+	ctx, cancel := c.methodContext(ctx, "GetPoolBLSToExecutionChanges")
+	defer cancel()
+	response, err := c.getBLSToExecutionChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]beacon.SignedBLSToExecutionChange, len(response.Data))
+	for i, change := range response.Data {
+		changes[i] = change.toBeacon()
+	}
+	return changes, nil
+}
+
+// Send attester slashing request
+func (c *StandardHttpClient) postAttesterSlashing(ctx context.Context, request AttesterSlashing) error {
+	responseBody, status, err := c.postRequest(ctx, RequestAttesterSlashingsPath, request)
+	if err != nil {
+		return fmt.Errorf("Could not broadcast attester slashing: %w", err)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Could not broadcast attester slashing: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	return nil
+}
+
+// Send proposer slashing request
+func (c *StandardHttpClient) postProposerSlashing(ctx context.Context, request ProposerSlashing) error {
+	responseBody, status, err := c.postRequest(ctx, RequestProposerSlashingsPath, request)
+	if err != nil {
+		return fmt.Errorf("Could not broadcast proposer slashing for validator %s: %w", request.SignedHeader1.Message.ProposerIndex, err)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Could not broadcast proposer slashing for validator %s: HTTP status %d; response body: '%s'", request.SignedHeader1.Message.ProposerIndex, status, string(responseBody))
+	}
+	return nil
+}
+
+// Send attestations
+func (c *StandardHttpClient) postAttestations(ctx context.Context, requests []Attestation) error {
+	responseBody, status, err := c.postRequest(ctx, RequestPoolAttestationsPath, requests)
+	if err != nil {
+		return fmt.Errorf("Could not broadcast attestations: %w", err)
+	}
+	if status == http.StatusBadRequest {
+		if batchErr := parseBatchSubmissionError(responseBody, "Could not broadcast attestations"); batchErr != nil {
+			return batchErr
+		}
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Could not broadcast attestations: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	return nil
+}
+
+// Send sync committee messages
+func (c *StandardHttpClient) postSyncCommitteeMessages(ctx context.Context, requests []SyncCommitteeMessageRequest) error {
+	responseBody, status, err := c.postRequest(ctx, RequestPoolSyncCommitteesPath, requests)
+	if err != nil {
+		return fmt.Errorf("Could not broadcast sync committee messages: %w", err)
+	}
+	if status == http.StatusBadRequest {
+		if batchErr := parseBatchSubmissionError(responseBody, "Could not broadcast sync committee messages"); batchErr != nil {
+			return batchErr
+		}
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Could not broadcast sync committee messages: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	return nil
+}
+
+// Get the attester slashings in the pool
+func (c *StandardHttpClient) getAttesterSlashings(ctx context.Context) (AttesterSlashingsResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestAttesterSlashingsPath)
+	if err != nil {
+		return AttesterSlashingsResponse{}, fmt.Errorf("Could not get pool attester slashings: %w", err)
+	}
+	if status != http.StatusOK {
+		return AttesterSlashingsResponse{}, fmt.Errorf("Could not get pool attester slashings: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var slashings AttesterSlashingsResponse
+	if err := decodeResponse(responseBody, &slashings); err != nil {
+		return AttesterSlashingsResponse{}, fmt.Errorf("Could not decode pool attester slashings: %w", err)
+	}
+	return slashings, nil
+}
+
+// Get the proposer slashings in the pool
+func (c *StandardHttpClient) getProposerSlashings(ctx context.Context) (ProposerSlashingsResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestProposerSlashingsPath)
+	if err != nil {
+		return ProposerSlashingsResponse{}, fmt.Errorf("Could not get pool proposer slashings: %w", err)
+	}
+	if status != http.StatusOK {
+		return ProposerSlashingsResponse{}, fmt.Errorf("Could not get pool proposer slashings: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var slashings ProposerSlashingsResponse
+	if err := decodeResponse(responseBody, &slashings); err != nil {
+		return ProposerSlashingsResponse{}, fmt.Errorf("Could not decode pool proposer slashings: %w", err)
+	}
+	return slashings, nil
+}
+
+// Get the voluntary exits in the pool
+func (c *StandardHttpClient) getVoluntaryExits(ctx context.Context) (VoluntaryExitsResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestVoluntaryExitPath)
+	if err != nil {
+		return VoluntaryExitsResponse{}, fmt.Errorf("Could not get pool voluntary exits: %w", err)
+	}
+	if status != http.StatusOK {
+		return VoluntaryExitsResponse{}, fmt.Errorf("Could not get pool voluntary exits: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var exits VoluntaryExitsResponse
+	if err := decodeResponse(responseBody, &exits); err != nil {
+		return VoluntaryExitsResponse{}, fmt.Errorf("Could not decode pool voluntary exits: %w", err)
+	}
+	return exits, nil
+}
+
+// Get the withdrawal credentials changes in the pool
+func (c *StandardHttpClient) getBLSToExecutionChanges(ctx context.Context) (BLSToExecutionChangesResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestWithdrawalCredentialsChangePath)
+	if err != nil {
+		return BLSToExecutionChangesResponse{}, fmt.Errorf("Could not get pool withdrawal credentials changes: %w", err)
+	}
+	if status != http.StatusOK {
+		return BLSToExecutionChangesResponse{}, fmt.Errorf("Could not get pool withdrawal credentials changes: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var changes BLSToExecutionChangesResponse
+	if err := decodeResponse(responseBody, &changes); err != nil {
+		return BLSToExecutionChangesResponse{}, fmt.Errorf("Could not decode pool withdrawal credentials changes: %w", err)
+	}
+	return changes, nil
+}
+
+// Conversions between the API and beacon types
+func (r VoluntaryExitRequest) toBeacon() beacon.SignedVoluntaryExit {
+	return beacon.SignedVoluntaryExit{
+		ValidatorIndex: r.Message.ValidatorIndex,
+		Epoch:          uint64(r.Message.Epoch),
+		Signature:      types.BytesToValidatorSignature(r.Signature),
+	}
+}
+
+func blsToExecutionChangeFromBeacon(change beacon.SignedBLSToExecutionChange) BLSToExecutionChangeRequest {
+	return BLSToExecutionChangeRequest{
+		Message: BLSToExecutionChangeMessage{
+			ValidatorIndex:     change.ValidatorIndex,
+			FromBLSPubkey:      change.FromBLSPubkey[:],
+			ToExecutionAddress: change.ToExecutionAddress[:],
+		},
+		Signature: change.Signature.Bytes(),
+	}
+}
+func (r BLSToExecutionChangeRequest) toBeacon() beacon.SignedBLSToExecutionChange {
+	return beacon.SignedBLSToExecutionChange{
+		ValidatorIndex:     r.Message.ValidatorIndex,
+		FromBLSPubkey:      types.BytesToValidatorPubkey(r.Message.FromBLSPubkey),
+		ToExecutionAddress: common.BytesToAddress(r.Message.ToExecutionAddress),
+		Signature:          types.BytesToValidatorSignature(r.Signature),
+	}
+}
+
+func checkpointFromBeacon(checkpoint beacon.Checkpoint) Checkpoint {
+	return Checkpoint{
+		Epoch: uinteger(checkpoint.Epoch),
+		Root:  checkpoint.Root.Bytes(),
+	}
+}
+func (c Checkpoint) toBeacon() beacon.Checkpoint {
+	return beacon.Checkpoint{
+		Epoch: uint64(c.Epoch),
+		Root:  common.BytesToHash(c.Root),
+	}
+}
+
+func attestationDataFromBeacon(data beacon.AttestationData) AttestationData {
+	return AttestationData{
+		Slot:            uinteger(data.Slot),
+		Index:           uinteger(data.Index),
+		BeaconBlockRoot: data.BeaconBlockRoot.Bytes(),
+		Source:          checkpointFromBeacon(data.Source),
+		Target:          checkpointFromBeacon(data.Target),
+	}
+}
+func (d AttestationData) toBeacon() beacon.AttestationData {
+	return beacon.AttestationData{
+		Slot:            uint64(d.Slot),
+		Index:           uint64(d.Index),
+		BeaconBlockRoot: common.BytesToHash(d.BeaconBlockRoot),
+		Source:          d.Source.toBeacon(),
+		Target:          d.Target.toBeacon(),
+	}
+}
+
+func indexedAttestationFromBeacon(attestation beacon.IndexedAttestation) IndexedAttestation {
+	return IndexedAttestation{
+		AttestingIndices: attestation.AttestingIndices,
+		Data:             attestationDataFromBeacon(attestation.Data),
+		Signature:        attestation.Signature.Bytes(),
+	}
+}
+func (a IndexedAttestation) toBeacon() beacon.IndexedAttestation {
+	return beacon.IndexedAttestation{
+		AttestingIndices: a.AttestingIndices,
+		Data:             a.Data.toBeacon(),
+		Signature:        types.BytesToValidatorSignature(a.Signature),
+	}
+}
+
+func attestationFromBeacon(attestation beacon.Attestation) Attestation {
+	return Attestation{
+		AggregationBits: hexutil.AddPrefix(hex.EncodeToString(attestation.AggregationBits)),
+		Data:            attestationDataFromBeacon(attestation.Data),
+		Signature:       attestation.Signature.Bytes(),
+	}
+}
+func (a Attestation) toBeacon() (beacon.Attestation, error) {
+	aggregationBits, err := hex.DecodeString(hexutil.RemovePrefix(a.AggregationBits))
+	if err != nil {
+		return beacon.Attestation{}, fmt.Errorf("error decoding aggregation bits: %w", err)
+	}
+	return beacon.Attestation{
+		AggregationBits: aggregationBits,
+		Data:            a.Data.toBeacon(),
+		Signature:       types.BytesToValidatorSignature(a.Signature),
+	}, nil
+}
+func (a Attestation) toBeaconInfo() (beacon.AttestationInfo, error) {
+	aggregationBits, err := hex.DecodeString(hexutil.RemovePrefix(a.AggregationBits))
+	if err != nil {
+		return beacon.AttestationInfo{}, fmt.Errorf("error decoding aggregation bits: %w", err)
+	}
+	return beacon.AttestationInfo{
+		AggregationBits: aggregationBits,
+		SlotIndex:       uint64(a.Data.Slot),
+		CommitteeIndex:  uint64(a.Data.Index),
+		BeaconBlockRoot: common.BytesToHash(a.Data.BeaconBlockRoot),
+		Source:          a.Data.Source.toBeacon(),
+		Target:          a.Data.Target.toBeacon(),
+	}, nil
+}
+
+func signedBeaconBlockHeaderFromBeacon(header beacon.SignedBeaconBlockHeader) SignedBeaconBlockHeader {
+	return SignedBeaconBlockHeader{
+		Message: BeaconBlockHeader{
+			Slot:          uinteger(header.Message.Slot),
+			ProposerIndex: header.Message.ProposerIndex,
+			ParentRoot:    header.Message.ParentRoot.Bytes(),
+			StateRoot:     header.Message.StateRoot.Bytes(),
+			BodyRoot:      header.Message.BodyRoot.Bytes(),
+		},
+		Signature: header.Signature.Bytes(),
+	}
+}
+func (h BeaconBlockHeader) toBeacon() beacon.BeaconBlockHeader {
+	return beacon.BeaconBlockHeader{
+		Slot:          uint64(h.Slot),
+		ProposerIndex: h.ProposerIndex,
+		ParentRoot:    common.BytesToHash(h.ParentRoot),
+		StateRoot:     common.BytesToHash(h.StateRoot),
+		BodyRoot:      common.BytesToHash(h.BodyRoot),
+	}
+}
+func (h SignedBeaconBlockHeader) toBeacon() beacon.SignedBeaconBlockHeader {
+	return beacon.SignedBeaconBlockHeader{
+		Message:   h.Message.toBeacon(),
+		Signature: types.BytesToValidatorSignature(h.Signature),
+	}
+}
+
+func (h BlockHeader) toBeacon() beacon.BlockHeader {
+	return beacon.BlockHeader{
+		Root:      common.BytesToHash(h.Root),
+		Canonical: h.Canonical,
+		Header:    h.Header.Message.toBeacon(),
+	}
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Graffiti is a fixed 32-byte field in blocks
+const GraffitiLength = 32
+
+// Have the node produce an unsigned block for the provided slot.
+// The block is returned as raw JSON along with the fork it was built for, which determines its structure.
+func (c *StandardHttpClient) ProduceBlock(ctx context.Context, slot uint64, randaoReveal types.ValidatorSignature, graffiti []byte) (beacon.ProducedBlock, error) {
+	ctx, cancel := c.methodContext(ctx, "ProduceBlock")
+	defer cancel()
+	response, version, err := c.produceBlock(ctx, slot, randaoReveal, graffiti)
+	if err != nil {
+		return beacon.ProducedBlock{}, err
+	}
+
+	// The header is authoritative, but older nodes only report the version in the body
+	if version == "" {
+		version = response.Version
+	}
+	return beacon.ProducedBlock{
+		Version: version,
+		Block:   response.Data,
+	}, nil
+}
+
+// Have the node produce an unsigned blinded block for the provided slot, using a payload header from its builder if it has one.
+// The payload's fee recipient and gas limit are included so the builder's bid can be checked against expectations.
+func (c *StandardHttpClient) ProduceBlindedBlock(ctx context.Context, slot uint64, randaoReveal types.ValidatorSignature, graffiti []byte) (beacon.ProducedBlindedBlock, error) {
+	ctx, cancel := c.methodContext(ctx, "ProduceBlindedBlock")
+	defer cancel()
+	response, version, err := c.produceBlindedBlock(ctx, slot, randaoReveal, graffiti)
+	if err != nil {
+		return beacon.ProducedBlindedBlock{}, err
+	}
+	if version == "" {
+		version = response.Version
+	}
+
+	var header BlindedBlockHeader
+	if err := decodeResponse(response.Data, &header); err != nil {
+		return beacon.ProducedBlindedBlock{}, fmt.Errorf("Could not decode blinded block payload header for slot %d: %w", slot, err)
+	}
+	return beacon.ProducedBlindedBlock{
+		ProducedBlock: beacon.ProducedBlock{
+			Version: version,
+			Block:   response.Data,
+		},
+		FeeRecipient: common.BytesToAddress(header.Body.ExecutionPayloadHeader.FeeRecipient),
+		GasLimit:     uint64(header.Body.ExecutionPayloadHeader.GasLimit),
+	}, nil
+}
+
+// Check if the node supports producing blinded blocks.
+// This requests a block without the required parameters; nodes that have the route reject it with a 400 instead of a 404.
+func (c *StandardHttpClient) SupportsBlindedBlocks(ctx context.Context) (bool, error) {
+	ctx, cancel := c.methodContext(ctx, "SupportsBlindedBlocks")
+	defer cancel()
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestProduceBlindedBlockPath, "0"))
+	if err != nil {
+		return false, fmt.Errorf("Could not check blinded block support: %w", err)
+	}
+	switch status {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return false, nil
+	case http.StatusBadRequest, http.StatusOK:
+		return true, nil
+	}
+	return false, fmt.Errorf("Could not check blinded block support: HTTP status %d; response body: '%s'", status, string(responseBody))
+}
+
+// Produce a block
+func (c *StandardHttpClient) produceBlock(ctx context.Context, slot uint64, randaoReveal types.ValidatorSignature, graffiti []byte) (ProduceBlockResponse, string, error) {
+	query, err := blockProductionQuery(randaoReveal, graffiti)
+	if err != nil {
+		return ProduceBlockResponse{}, "", fmt.Errorf("Could not produce block for slot %d: %w", slot, err)
+	}
+	responseBody, status, header, err := c.getRequestWithHeader(ctx, fmt.Sprintf(RequestProduceBlockPath, strconv.FormatUint(slot, 10))+"?"+query)
+	if err != nil {
+		return ProduceBlockResponse{}, "", fmt.Errorf("Could not produce block for slot %d: %w", slot, err)
+	}
+	if status != http.StatusOK {
+		return ProduceBlockResponse{}, "", fmt.Errorf("Could not produce block for slot %d: HTTP status %d; response body: '%s'", slot, status, string(responseBody))
+	}
+	var block ProduceBlockResponse
+	if err := decodeResponse(responseBody, &block); err != nil {
+		return ProduceBlockResponse{}, "", fmt.Errorf("Could not decode produced block for slot %d: %w", slot, err)
+	}
+	return block, header.Get(ConsensusVersionHeader), nil
+}
+
+// Produce a blinded block
+func (c *StandardHttpClient) produceBlindedBlock(ctx context.Context, slot uint64, randaoReveal types.ValidatorSignature, graffiti []byte) (ProduceBlockResponse, string, error) {
+	query, err := blockProductionQuery(randaoReveal, graffiti)
+	if err != nil {
+		return ProduceBlockResponse{}, "", fmt.Errorf("Could not produce blinded block for slot %d: %w", slot, err)
+	}
+	responseBody, status, header, err := c.getRequestWithHeader(ctx, fmt.Sprintf(RequestProduceBlindedBlockPath, strconv.FormatUint(slot, 10))+"?"+query)
+	if err != nil {
+		return ProduceBlockResponse{}, "", fmt.Errorf("Could not produce blinded block for slot %d: %w", slot, err)
+	}
+	if status != http.StatusOK {
+		return ProduceBlockResponse{}, "", fmt.Errorf("Could not produce blinded block for slot %d: HTTP status %d; response body: '%s'", slot, status, string(responseBody))
+	}
+	var block ProduceBlockResponse
+	if err := decodeResponse(responseBody, &block); err != nil {
+		return ProduceBlockResponse{}, "", fmt.Errorf("Could not decode produced blinded block for slot %d: %w", slot, err)
+	}
+	return block, header.Get(ConsensusVersionHeader), nil
+}
+
+// Build the query parameters shared by the block production routes
+func blockProductionQuery(randaoReveal types.ValidatorSignature, graffiti []byte) (string, error) {
+	if len(graffiti) > GraffitiLength {
+		return "", fmt.Errorf("graffiti is %d bytes, but can be at most %d", len(graffiti), GraffitiLength)
+	}
+	paddedGraffiti := make([]byte, GraffitiLength)
+	copy(paddedGraffiti, graffiti)
+
+	query := url.Values{}
+	query.Set("randao_reveal", hexutil.AddPrefix(randaoReveal.Hex()))
+	query.Set("graffiti", hexutil.AddPrefix(hex.EncodeToString(paddedGraffiti)))
+	return query.Encode(), nil
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Get the fee recipient the node has configured for the provided validator.
+// Most Beacon nodes leave fee recipients to the validator client; those return an error wrapping beacon.ErrEndpointNotSupported.
+func (c *StandardHttpClient) GetFeeRecipient(ctx context.Context, pubkey types.ValidatorPubkey) (common.Address, error) {
+	ctx, cancel := c.methodContext(ctx, "GetFeeRecipient")
+	defer cancel()
+	response, err := c.getFeeRecipient(ctx, pubkey)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(response.Data.EthAddress), nil
+}
+
+// Get the fee recipient for a validator
+func (c *StandardHttpClient) getFeeRecipient(ctx context.Context, pubkey types.ValidatorPubkey) (FeeRecipientResponse, error) {
+	pubkeyString := hexutil.AddPrefix(pubkey.Hex())
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestFeeRecipientPath, pubkeyString))
+	if err != nil {
+		return FeeRecipientResponse{}, fmt.Errorf("Could not get fee recipient for validator %s: %w", pubkeyString, err)
+	}
+	switch status {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return FeeRecipientResponse{}, fmt.Errorf("Could not get fee recipient for validator %s: %w", pubkeyString, beacon.ErrEndpointNotSupported)
+	}
+	if status != http.StatusOK {
+		return FeeRecipientResponse{}, fmt.Errorf("Could not get fee recipient for validator %s: HTTP status %d; response body: '%s'", pubkeyString, status, string(responseBody))
+	}
+	var feeRecipient FeeRecipientResponse
+	if err := decodeResponse(responseBody, &feeRecipient); err != nil {
+		return FeeRecipientResponse{}, fmt.Errorf("Could not decode fee recipient for validator %s: %w", pubkeyString, err)
+	}
+	return feeRecipient, nil
+}
+
+// Tell the node which fee recipient to use when building blocks for each of the provided validators.
+// If the node rejects some of them, the returned error is a *beacon.BatchSubmissionError describing each rejection.
+func (c *StandardHttpClient) PrepareBeaconProposer(ctx context.Context, proposers []beacon.ProposerPreparation) error {
+	ctx, cancel := c.methodContext(ctx, "PrepareBeaconProposer")
+	defer cancel()
+	if len(proposers) == 0 {
+		return nil
+	}
+
+	requests := make([]ProposerPreparationRequest, len(proposers))
+	for i, proposer := range proposers {
+		if proposer.FeeRecipient == (common.Address{}) {
+			return fmt.Errorf("Could not prepare beacon proposers: validator %s does not have a fee recipient set", proposer.ValidatorIndex)
+		}
+		requests[i] = ProposerPreparationRequest{
+			ValidatorIndex: proposer.ValidatorIndex,
+			FeeRecipient:   proposer.FeeRecipient.Bytes(),
+		}
+	}
+	return c.postPrepareBeaconProposer(ctx, requests)
+}
+
+// Send proposer preparations
+func (c *StandardHttpClient) postPrepareBeaconProposer(ctx context.Context, requests []ProposerPreparationRequest) error {
+	responseBody, status, err := c.postRequest(ctx, RequestPrepareBeaconProposerPath, requests)
+	if err != nil {
+		return fmt.Errorf("Could not prepare beacon proposers: %w", err)
+	}
+	if status == http.StatusBadRequest {
+		if batchErr := parseBatchSubmissionError(responseBody, "Could not prepare beacon proposers"); batchErr != nil {
+			return batchErr
+		}
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Could not prepare beacon proposers: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	return nil
+}
+
+// Submit builder registrations for the provided validators.
+// Registrations are sent in batches small enough for typical body size limits; batches the node still rejects as too large are split further.
+// If the node rejects some registrations, the returned error is a *beacon.BatchSubmissionError keyed by position in the provided slice.
+func (c *StandardHttpClient) RegisterValidators(ctx context.Context, registrations []beacon.SignedValidatorRegistration) error {
+	ctx, cancel := c.methodContext(ctx, "RegisterValidators")
+	defer cancel()
+
+	requests := make([]SignedValidatorRegistrationRequest, len(registrations))
+	for i, registration := range registrations {
+		requests[i].Message.FeeRecipient = registration.FeeRecipient.Bytes()
+		requests[i].Message.GasLimit = uinteger(registration.GasLimit)
+		requests[i].Message.Timestamp = uinteger(registration.Timestamp)
+		requests[i].Message.Pubkey = registration.Pubkey.Bytes()
+		requests[i].Signature = registration.Signature.Bytes()
+	}
+
+	batchErr := &beacon.BatchSubmissionError{
+		Message:  "Could not register validators",
+		Failures: map[int]string{},
+	}
+	for start := 0; start < len(requests); start += MaxRegistrationsCount {
+		end := start + MaxRegistrationsCount
+		if end > len(requests) {
+			end = len(requests)
+		}
+		if err := c.postRegisterValidators(ctx, requests[start:end], start, batchErr); err != nil {
+			return err
+		}
+	}
+	if len(batchErr.Failures) > 0 {
+		return batchErr
+	}
+	return nil
+}
+
+// Send builder registrations, splitting the batch if it's too large.
+// Per-registration failures are added to batchErr, offset by the batch's position in the full list.
+func (c *StandardHttpClient) postRegisterValidators(ctx context.Context, requests []SignedValidatorRegistrationRequest, offset int, batchErr *beacon.BatchSubmissionError) error {
+	responseBody, status, err := c.postRequest(ctx, RequestRegisterValidatorPath, requests)
+	if err != nil {
+		return fmt.Errorf("Could not register validators: %w", err)
+	}
+	if status == http.StatusRequestEntityTooLarge && len(requests) > 1 {
+		half := len(requests) / 2
+		if err := c.postRegisterValidators(ctx, requests[:half], offset, batchErr); err != nil {
+			return err
+		}
+		return c.postRegisterValidators(ctx, requests[half:], offset+half, batchErr)
+	}
+	if status == http.StatusBadRequest {
+		if failures := parseBatchSubmissionError(responseBody, batchErr.Message); failures != nil {
+			for index, message := range failures.Failures {
+				batchErr.Failures[offset+index] = message
+			}
+			return nil
+		}
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Could not register validators: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	return nil
+}
+
+// Parse a response that reports which items of a batch the node rejected.
+// Returns nil if the response doesn't have any per-item failures.
+func parseBatchSubmissionError(responseBody []byte, message string) *beacon.BatchSubmissionError {
+	var response IndexedErrorResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil || len(response.Failures) == 0 {
+		return nil
+	}
+	batchErr := &beacon.BatchSubmissionError{
+		Message:  fmt.Sprintf("%s: %s", message, response.Message),
+		Failures: make(map[int]string, len(response.Failures)),
+	}
+	for _, failure := range response.Failures {
+		batchErr.Failures[int(failure.Index)] = failure.Message
+	}
+	return batchErr
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// Prysm's gRPC-gateway routes, used when its standard beacon API routes aren't available
+const (
+	RequestPrysmCommitteesPath = "/eth/v1alpha1/beacon/committees"
+)
+
+type PrysmCommitteesResponse struct {
+	Epoch      uinteger `json:"epoch"`
+	Committees map[string]struct {
+		Committees []struct {
+			ValidatorIndices []string `json:"validator_indices"`
+		} `json:"committees"`
+	} `json:"committees"`
+}
+
+// Get the implementation of the node this client is connected to.
+// The result is cached once it's been determined; failed lookups are retried on the next call.
+func (c *StandardHttpClient) getImplementation(ctx context.Context) string {
+	c.implementationLock.Lock()
+	defer c.implementationLock.Unlock()
+	if c.implementation != "" {
+		return c.implementation
+	}
+	version, err := c.getNodeVersion(ctx)
+	if err != nil {
+		return beacon.Implementation_Unknown
+	}
+	c.implementation = parseNodeVersion(version.Data.Version).Implementation
+	return c.implementation
+}
+
+// Check if the node this client is connected to is Prysm
+func (c *StandardHttpClient) isPrysm(ctx context.Context) bool {
+	return c.getImplementation(ctx) == beacon.Implementation_Prysm
+}
+
+// Get validator balances from an older Prysm node, which doesn't accept the ID list as a POST body.
+// The IDs are requested over GET instead, in chunks whose query strings are no longer than MaxValidatorsQueryLength.
+func (c *StandardHttpClient) getPrysmValidatorBalances(ctx context.Context, stateId string, pubkeysOrIndices []string) (ValidatorBalancesResponse, error) {
+	var balances ValidatorBalancesResponse
+	for start := 0; start < len(pubkeysOrIndices); {
+
+		// Add IDs to the chunk until the next one would take the query past the limit, but always take at least one
+		end := start + 1
+		queryLength := len(validatorBalancesQuery(pubkeysOrIndices[start:end]))
+		for end < len(pubkeysOrIndices) && queryLength+1+len(pubkeysOrIndices[end]) <= MaxValidatorsQueryLength {
+			queryLength += 1 + len(pubkeysOrIndices[end])
+			end++
+		}
+
+		chunk, err := c.getValidatorBalances(ctx, stateId, pubkeysOrIndices[start:end])
+		if err != nil {
+			return ValidatorBalancesResponse{}, err
+		}
+		balances.Data = append(balances.Data, chunk.Data...)
+		start = end
+	}
+	return balances, nil
+}
+
+// Get the committees for an epoch from Prysm's gRPC-gateway route, filtered by committee index and slot.
+// That route only knows about epochs, so it can't serve arbitrary states.
+func (c *StandardHttpClient) getPrysmCommittees(ctx context.Context, stateId string, epoch *uint64, index *uint64, slot *uint64) (CommitteesResponse, error) {
+	if epoch == nil && stateId != "head" {
+		return CommitteesResponse{}, fmt.Errorf("Could not get committees for state %s: Prysm committees can only be requested by epoch", stateId)
+	}
+
+	requestPath := RequestPrysmCommitteesPath
+	if epoch != nil {
+		requestPath += "?" + url.Values{"epoch": {strconv.FormatUint(*epoch, 10)}}.Encode()
+	}
+	responseBody, status, err := c.getRequest(ctx, requestPath)
+	if err != nil {
+		return CommitteesResponse{}, fmt.Errorf("Could not get Prysm committees: %w", err)
+	}
+	if status != http.StatusOK {
+		return CommitteesResponse{}, fmt.Errorf("Could not get Prysm committees: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var prysmCommittees PrysmCommitteesResponse
+	if err := json.Unmarshal(responseBody, &prysmCommittees); err != nil {
+		return CommitteesResponse{}, fmt.Errorf("Could not decode Prysm committees: %w", err)
+	}
+
+	// Committees are keyed by slot, with the committee index being the position within that slot
+	var committees CommitteesResponse
+	for slotString, slotCommittees := range prysmCommittees.Committees {
+		committeeSlot, err := strconv.ParseUint(slotString, 10, 64)
+		if err != nil {
+			return CommitteesResponse{}, fmt.Errorf("Could not decode Prysm committees: invalid slot '%s'", slotString)
+		}
+		if slot != nil && committeeSlot != *slot {
+			continue
+		}
+		for committeeIndex, committee := range slotCommittees.Committees {
+			if index != nil && uint64(committeeIndex) != *index {
+				continue
+			}
+			committees.Data = append(committees.Data, Committee{
+				Index:      uinteger(committeeIndex),
+				Slot:       uinteger(committeeSlot),
+				Validators: committee.ValidatorIndices,
+			})
+		}
+	}
+	sort.Slice(committees.Data, func(i, j int) bool {
+		if committees.Data[i].Slot != committees.Data[j].Slot {
+			return committees.Data[i].Slot < committees.Data[j].Slot
+		}
+		return committees.Data[i].Index < committees.Data[j].Index
+	})
+	return committees, nil
+}
@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/utils/eth2"
+)
+
+// MAX_SEED_LOOKAHEAD from the spec; activations and exits take effect this many epochs after the epoch following the one they're processed in
+const maxSeedLookahead uint64 = 4
+
+// Estimate how many epochs from now a validator that became eligible for activation at the provided epoch will be activated.
+// The estimate assumes the active validator set, and therefore the churn limit, stays as it is now, and that the chain keeps
+// finalizing normally. Before Electra, a fixed number of validators are activated per epoch, so this counts the validators
+// ahead of it in the activation queue. From Electra onwards, every validator whose eligibility has been finalized is activated
+// at once, and the queue is for deposits instead: it's drained by balance, up to the activation churn limit in gwei per epoch,
+// and validators only get an eligibility epoch once their deposit leaves it. A validator whose deposit is still queued should
+// pass FarFutureEpoch, and is assumed to be at the back of the queue.
+// Counting the active set means fetching it, so this is an expensive call.
+func (c *StandardHttpClient) EstimateActivationTime(ctx context.Context, activationEligibilityEpoch uint64) (uint64, error) {
+	ctx, cancel := c.methodContext(ctx, "EstimateActivationTime")
+	defer cancel()
+
+	config, err := c.GetEth2Config(ctx)
+	if err != nil {
+		return 0, err
+	}
+	finalityCheckpoints, err := c.getFinalityCheckpoints(ctx, "head")
+	if err != nil {
+		return 0, err
+	}
+	activeCount, activeBalance, err := c.getActiveSet(ctx)
+	if err != nil {
+		return 0, err
+	}
+	currentEpoch := eth2.EpochAt(config, uint64(time.Now().Unix()))
+	finalizedEpoch := uint64(finalityCheckpoints.Data.Finalized.Epoch)
+
+	var queueEpochs uint64
+	if currentEpoch >= config.ElectraForkEpoch {
+		if activationEligibilityEpoch == beacon.FarFutureEpoch {
+			// Wait for the deposits ahead of it to be processed, then for the eligibility epoch it's given after that to be finalized
+			queueEpochs, err = c.getPendingDepositEpochs(ctx, config, activeBalance)
+			if err != nil {
+				return 0, err
+			}
+			activationEligibilityEpoch = currentEpoch + queueEpochs + 1
+		}
+	} else {
+		queued, err := c.getValidators(ctx, "head", nil, []ValidatorStatus{ValidatorStatus_PendingQueued})
+		if err != nil {
+			return 0, fmt.Errorf("Could not get the activation queue: %w", err)
+		}
+		defer queued.Release()
+
+		// The queue is ordered by eligibility epoch, so everything that became eligible no later than this validator is ahead of it
+		var position uint64
+		for _, validator := range queued.Data {
+			if uint64(validator.Validator.ActivationEligibilityEpoch) <= activationEligibilityEpoch {
+				position++
+			}
+		}
+		if position == 0 {
+			position = 1
+		}
+		churn := getActivationChurnLimit(config, activeCount, currentEpoch)
+		queueEpochs = (position + churn - 1) / churn
+	}
+
+	// Validators can't be activated until their eligibility has been finalized, assuming finality advances an epoch per epoch
+	if activationEligibilityEpoch > finalizedEpoch {
+		finalityEpochs := activationEligibilityEpoch - finalizedEpoch
+		if finalityEpochs > queueEpochs {
+			queueEpochs = finalityEpochs
+		}
+	}
+
+	// Once dequeued, the activation takes effect after the seed lookahead
+	return queueEpochs + 1 + maxSeedLookahead, nil
+}
+
+// Get the number of epochs it will take to process every deposit that's currently pending, for Electra onwards
+func (c *StandardHttpClient) getPendingDepositEpochs(ctx context.Context, config beacon.Eth2Config, activeBalance uint64) (uint64, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestPendingDepositsPath, "head"))
+	if err != nil {
+		return 0, fmt.Errorf("Could not get pending deposits: %w", err)
+	}
+	if status != http.StatusOK {
+		return 0, fmt.Errorf("Could not get pending deposits: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var deposits PendingDepositsResponse
+	if err := decodeResponse(responseBody, &deposits); err != nil {
+		return 0, fmt.Errorf("Could not decode pending deposits: %w", err)
+	}
+
+	var pendingBalance uint64
+	for _, deposit := range deposits.Data {
+		pendingBalance += uint64(deposit.Amount)
+	}
+	churn := getActivationExitChurnLimit(config, activeBalance)
+	epochs := (pendingBalance + churn - 1) / churn
+	if epochs == 0 {
+		epochs = 1
+	}
+	return epochs, nil
+}
+
+// Estimate when a validator that submits a voluntary exit now would exit, and when it would become withdrawable after that.
+// The exit queue is taken from the exit epochs already assigned to exiting validators, so the only assumption is that
+// the churn limit doesn't change and no other exits are processed first. Before Electra, a fixed number of validators can
+// exit per epoch. From Electra onwards, exits are limited by balance instead, so the estimate follows how the spec assigns
+// exit epochs from its earliest exit epoch and the balance left to consume in it, for a validator with a 32 ETH effective
+// balance like a minipool's.
+// Counting the active set means fetching it, so this is an expensive call.
+func (c *StandardHttpClient) EstimateExitTime(ctx context.Context) (beacon.ExitTimeEstimate, error) {
+	ctx, cancel := c.methodContext(ctx, "EstimateExitTime")
+	defer cancel()
+
+	config, err := c.GetEth2Config(ctx)
+	if err != nil {
+		return beacon.ExitTimeEstimate{}, err
+	}
+	activeCount, activeBalance, err := c.getActiveSet(ctx)
+	if err != nil {
+		return beacon.ExitTimeEstimate{}, err
+	}
+	exiting, err := c.getValidators(ctx, "head", nil, []ValidatorStatus{ValidatorStatus_ActiveExiting, ValidatorStatus_ActiveSlashed})
+	if err != nil {
+		return beacon.ExitTimeEstimate{}, fmt.Errorf("Could not get the exit queue: %w", err)
+	}
+	defer exiting.Release()
+	currentEpoch := eth2.EpochAt(config, uint64(time.Now().Unix()))
+
+	// New exits go to the end of the queue, which is the latest exit epoch assigned so far, but no sooner than the seed lookahead allows
+	exitEpoch := currentEpoch + 1 + maxSeedLookahead
+	for _, validator := range exiting.Data {
+		validatorExitEpoch := uint64(validator.Validator.ExitEpoch)
+		if validatorExitEpoch != beacon.FarFutureEpoch && validatorExitEpoch > exitEpoch {
+			exitEpoch = validatorExitEpoch
+		}
+	}
+
+	// Find how much of that epoch's churn has already been used
+	var exitEpochCount, exitEpochBalance uint64
+	for _, validator := range exiting.Data {
+		if uint64(validator.Validator.ExitEpoch) == exitEpoch {
+			exitEpochCount++
+			exitEpochBalance += uint64(validator.Validator.EffectiveBalance)
+		}
+	}
+
+	if currentEpoch >= config.ElectraForkEpoch {
+		// Following compute_exit_epoch_and_update_churn, the exit spills over into as many later epochs as its balance needs
+		churn := getActivationExitChurnLimit(config, activeBalance)
+		var balanceToConsume uint64
+		if exitEpochBalance < churn {
+			balanceToConsume = churn - exitEpochBalance
+		}
+		exitBalance := config.MaxEffectiveBalance
+		if exitBalance > balanceToConsume {
+			exitEpoch += (exitBalance-balanceToConsume-1)/churn + 1
+		}
+	} else if exitEpochCount >= getChurnLimit(config, activeCount) {
+		// If that epoch is already full, the exit is pushed to the next one
+		exitEpoch++
+	}
+
+	return beacon.ExitTimeEstimate{
+		CurrentEpoch:      currentEpoch,
+		ExitEpoch:         exitEpoch,
+		WithdrawableEpoch: exitEpoch + config.MinValidatorWithdrawabilityDelay,
+	}, nil
+}
+
+// Get the number of active validators in the head state, and their total effective balance
+func (c *StandardHttpClient) getActiveSet(ctx context.Context) (uint64, uint64, error) {
+	validators, err := c.getValidators(ctx, "head", nil, []ValidatorStatus{ValidatorStatus_ActiveOngoing, ValidatorStatus_ActiveExiting, ValidatorStatus_ActiveSlashed})
+	if err != nil {
+		return 0, 0, fmt.Errorf("Could not get the active validators: %w", err)
+	}
+	defer validators.Release()
+
+	var balance uint64
+	for _, validator := range validators.Data {
+		balance += uint64(validator.Validator.EffectiveBalance)
+	}
+	return uint64(len(validators.Data)), balance, nil
+}
+
+// Get the number of validators that can enter or leave the active set per epoch
+func getChurnLimit(config beacon.Eth2Config, activeCount uint64) uint64 {
+	churn := activeCount / config.ChurnLimitQuotient
+	if churn < config.MinPerEpochChurnLimit {
+		churn = config.MinPerEpochChurnLimit
+	}
+	return churn
+}
+
+// Get the number of validators that can be activated per epoch, which is capped further from Deneb onwards
+func getActivationChurnLimit(config beacon.Eth2Config, activeCount uint64, epoch uint64) uint64 {
+	churn := getChurnLimit(config, activeCount)
+	if epoch >= config.DenebForkEpoch && config.MaxPerEpochActivationChurnLimit > 0 && churn > config.MaxPerEpochActivationChurnLimit {
+		churn = config.MaxPerEpochActivationChurnLimit
+	}
+	return churn
+}
+
+// Get the balance in gwei that can enter or leave the active set per epoch from Electra onwards, following
+// get_activation_exit_churn_limit in the spec
+func getActivationExitChurnLimit(config beacon.Eth2Config, activeBalance uint64) uint64 {
+	churn := activeBalance / config.ChurnLimitQuotient
+	if churn < config.MinPerEpochChurnLimitElectra {
+		churn = config.MinPerEpochChurnLimitElectra
+	}
+	if config.EffectiveBalanceIncrement > 0 {
+		churn -= churn % config.EffectiveBalanceIncrement
+	}
+	if config.MaxPerEpochActivationExitChurnLimit > 0 && churn > config.MaxPerEpochActivationExitChurnLimit {
+		churn = config.MaxPerEpochActivationExitChurnLimit
+	}
+	if churn == 0 {
+		churn = 1
+	}
+	return churn
+}
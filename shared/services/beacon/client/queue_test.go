@@ -0,0 +1,155 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+const (
+	queueCurrentEpoch   = 1000
+	queueFinalizedEpoch = 998
+)
+
+// Start a mock node whose wall-clock epoch is queueCurrentEpoch, serving the validators through an SSZ state so their
+// statuses are derived and filtered the way a real node would
+func newQueueServer(electraForkEpoch uint64, validators []clienttest.Validator) (*clienttest.Server, *client.StandardHttpClient) {
+	server := clienttest.NewServer()
+	server.SetGenesis(clienttest.Genesis{
+		Time:        uint64(time.Now().Unix()) - queueCurrentEpoch*384 - 30,
+		ForkVersion: []byte{0, 0, 0, 0},
+	})
+	server.SetSpec("ELECTRA_FORK_EPOCH", strconv.FormatUint(electraForkEpoch, 10))
+	root := "0x4d8f3c7a6d7b3fa0a6f8e0b7d5f6c2b1e9b1fa2c6a0e3e9dd0b4c7a1f2e3d4c5"
+	server.SetData(fmt.Sprintf(client.RequestFinalityCheckpointsPath, "head"), map[string]any{
+		"previous_justified": map[string]string{"epoch": strconv.Itoa(queueFinalizedEpoch), "root": root},
+		"current_justified":  map[string]string{"epoch": strconv.Itoa(queueFinalizedEpoch + 1), "root": root},
+		"finalized":          map[string]string{"epoch": strconv.Itoa(queueFinalizedEpoch), "root": root},
+	})
+	server.SetResponse(fmt.Sprintf(client.RequestDebugBeaconStatePath, "head"), clienttest.Response{
+		Status: http.StatusOK,
+		Header: http.Header{"Content-Type": []string{client.RequestSszContentType}},
+		Body:   encodeBeaconState(queueCurrentEpoch*32, validators),
+	})
+	return server, client.NewStandardHttpClient(server.URL, client.WithSszValidators())
+}
+
+// Validators that are active, with 32 ETH each
+func activeValidators(count int) []clienttest.Validator {
+	validators := make([]clienttest.Validator, count)
+	for i := range validators {
+		validators[i] = clienttest.Validator{Balance: 32e9, EffectiveBalance: 32e9, ActivationEligibilityEpoch: 1, ActivationEpoch: 5, ExitEpoch: farFutureEpoch, WithdrawableEpoch: farFutureEpoch}
+	}
+	return validators
+}
+
+func TestEstimateActivationTimeBeforeElectra(t *testing.T) {
+	// Six queued validators are ahead of one that became eligible at epoch 995, and four are activated per epoch
+	validators := activeValidators(10)
+	for i := 0; i < 8; i++ {
+		validators = append(validators, clienttest.Validator{Balance: 32e9, EffectiveBalance: 32e9, ActivationEligibilityEpoch: uint64(990 + i), ActivationEpoch: farFutureEpoch, ExitEpoch: farFutureEpoch, WithdrawableEpoch: farFutureEpoch})
+	}
+	server, bc := newQueueServer(farFutureEpoch, validators)
+	defer server.Close()
+
+	epochs, err := bc.EstimateActivationTime(context.Background(), 995)
+	if err != nil {
+		t.Fatalf("error estimating activation time: %s", err.Error())
+	}
+	if epochs != 7 {
+		t.Errorf("expected 2 epochs in the queue plus 5 for the activation to take effect, got %d", epochs)
+	}
+}
+
+func TestEstimateActivationTimeElectra(t *testing.T) {
+	tests := []struct {
+		name                       string
+		activationEligibilityEpoch uint64
+		expected                   uint64
+	}{
+		// Every validator with a finalized eligibility epoch is activated at the next epoch transition
+		{name: "finalized eligibility", activationEligibilityEpoch: 990, expected: 5},
+		{name: "unfinalized eligibility", activationEligibilityEpoch: 999, expected: 6},
+
+		// 1000 ETH of deposits take 8 epochs at 128 ETH per epoch, then the eligibility epoch after that has to be finalized
+		{name: "queued deposit", activationEligibilityEpoch: beacon.FarFutureEpoch, expected: 8 + 3 + 5},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, bc := newQueueServer(900, activeValidators(10))
+			defer server.Close()
+			deposits := []map[string]string{}
+			for _, amount := range []uint64{32e9, 500e9, 468e9} {
+				deposits = append(deposits, map[string]string{
+					"pubkey":                 "0x" + fmt.Sprintf("%096x", amount),
+					"withdrawal_credentials": "0x" + fmt.Sprintf("%064x", 1),
+					"amount":                 strconv.FormatUint(amount, 10),
+					"signature":              "0x" + fmt.Sprintf("%0192x", 2),
+					"slot":                   "31000",
+				})
+			}
+			server.SetData(fmt.Sprintf(client.RequestPendingDepositsPath, "head"), deposits)
+
+			epochs, err := bc.EstimateActivationTime(context.Background(), test.activationEligibilityEpoch)
+			if err != nil {
+				t.Fatalf("error estimating activation time: %s", err.Error())
+			}
+			if epochs != test.expected {
+				t.Errorf("expected %d epochs, got %d", test.expected, epochs)
+			}
+			if count := server.RequestCount(fmt.Sprintf(client.RequestValidatorsPath, "head")); count != 0 {
+				t.Errorf("expected the activation queue not to be counted, got %d validators requests", count)
+			}
+		})
+	}
+}
+
+func TestEstimateExitTime(t *testing.T) {
+	tests := []struct {
+		name             string
+		electraForkEpoch uint64
+		exiting          int
+		exitingBalance   uint64
+		expected         uint64
+	}{
+		{name: "empty queue", electraForkEpoch: 900, exiting: 0, expected: 1005},
+
+		// 128 ETH can exit per epoch at this active balance, and each exiting validator has 32 ETH
+		{name: "epoch with churn left", electraForkEpoch: 900, exiting: 3, expected: 1010},
+		{name: "full epoch", electraForkEpoch: 900, exiting: 4, expected: 1011},
+		{name: "epoch filled by one compounding validator", electraForkEpoch: 900, exiting: 1, exitingBalance: 128e9, expected: 1011},
+
+		// Four validators can exit per epoch before Electra
+		{name: "epoch with churn left before Electra", electraForkEpoch: farFutureEpoch, exiting: 3, expected: 1010},
+		{name: "full epoch before Electra", electraForkEpoch: farFutureEpoch, exiting: 4, expected: 1011},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validators := activeValidators(10)
+			exitingBalance := test.exitingBalance
+			if exitingBalance == 0 {
+				exitingBalance = 32e9
+			}
+			for i := 0; i < test.exiting; i++ {
+				validators = append(validators, clienttest.Validator{Balance: exitingBalance, EffectiveBalance: exitingBalance, ActivationEligibilityEpoch: 1, ActivationEpoch: 5, ExitEpoch: 1010, WithdrawableEpoch: 1266})
+			}
+			server, bc := newQueueServer(test.electraForkEpoch, validators)
+			defer server.Close()
+
+			estimate, err := bc.EstimateExitTime(context.Background())
+			if err != nil {
+				t.Fatalf("error estimating exit time: %s", err.Error())
+			}
+			if estimate.CurrentEpoch != queueCurrentEpoch || estimate.ExitEpoch != test.expected || estimate.WithdrawableEpoch != test.expected+256 {
+				t.Errorf("expected current epoch %d, exit epoch %d, and withdrawable epoch %d; got %d, %d, and %d", queueCurrentEpoch, test.expected, test.expected+256, estimate.CurrentEpoch, estimate.ExitEpoch, estimate.WithdrawableEpoch)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// A token bucket limiting how fast requests are sent to the Beacon node
+type rateLimiter struct {
+	lock   sync.Mutex
+	rate   float64 // Tokens added per second
+	burst  float64 // The most tokens the bucket can hold
+	tokens float64 // Negative when callers are waiting on tokens that haven't been added yet
+	last   time.Time
+}
+
+// Limit requests to the Beacon node to rps requests per second on average, allowing bursts of up to burst requests.
+// This applies to every request the client makes, including the concurrent ones made by methods like GetBeaconBlocks.
+func WithRateLimit(rps float64, burst int) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		if rps <= 0 {
+			c.rateLimiter = nil
+			return
+		}
+		if burst < 1 {
+			burst = 1
+		}
+		c.rateLimiter = &rateLimiter{
+			rate:   rps,
+			burst:  float64(burst),
+			tokens: float64(burst),
+			last:   time.Now(),
+		}
+	}
+}
+
+// Wait until a request can be sent, or until the context is cancelled.
+// Each caller reserves a token right away, so waiting callers are served in the order they arrived.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.lock.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		l.lock.Unlock()
+		return nil
+	}
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.lock.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Give back the reserved token, since the request won't be sent
+		l.lock.Lock()
+		l.tokens++
+		l.lock.Unlock()
+		return ctx.Err()
+	}
+}
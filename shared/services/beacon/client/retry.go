@@ -0,0 +1,83 @@
+package client
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// The most the backoff will double, to keep the delay from overflowing
+const maxRetryBackoffShift = 16
+
+// The longest Retry-After the client will wait out on its own; longer ones are returned to the caller as a *beacon.RateLimitedError
+const maxRetryAfterWait = time.Minute
+
+// Policy for retrying requests that failed because of a transient Beacon node error
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+}
+
+// Get the delay before the next attempt, following the provided (1-based) failed attempt.
+// The delay doubles with each attempt, and is jittered between half and all of that value so concurrent callers spread out.
+func (p retryPolicy) delay(attempt int) time.Duration {
+	shift := attempt - 1
+	if shift > maxRetryBackoffShift {
+		shift = maxRetryBackoffShift
+	}
+	delay := p.baseDelay << shift
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// Check if a request failed in a way that's worth retrying: connection errors, server errors, and rate limiting are,
+// but other client errors (such as 400 or 404) will fail the same way every time
+func isRetryable(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch response.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Get how long a response asked the client to wait before retrying, from its Retry-After header.
+// The header can be a number of seconds or an HTTP date; returns false if it's missing or invalid.
+func retryAfter(response *http.Response, now time.Time) (time.Duration, bool) {
+	header := strings.TrimSpace(response.Header.Get("Retry-After"))
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseUint(header, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		delay := date.Sub(now).Round(time.Second)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// Get the error for a 429 response, including the delay it asked for
+func rateLimitedError(response *http.Response) error {
+	delay, _ := retryAfter(response, time.Now())
+	return &beacon.RateLimitedError{
+		RetryAfter: delay,
+	}
+}
@@ -0,0 +1,60 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+func TestRetrySucceedsAfterTransientErrors(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	server.SetSyncStatus(clienttest.SyncStatus{HeadSlot: 100})
+	server.SetError(client.RequestSyncStatusPath, http.StatusServiceUnavailable, "node is busy", 3)
+
+	bc := client.NewStandardHttpClient(server.URL, client.WithRetry(4, time.Millisecond))
+	status, err := bc.GetSyncStatus(context.Background())
+	if err != nil {
+		t.Fatalf("expected the request to succeed after retrying, got %s", err.Error())
+	}
+	if status.Syncing {
+		t.Error("expected the node to be reported as synced")
+	}
+	if count := server.RequestCount(client.RequestSyncStatusPath); count != 4 {
+		t.Errorf("expected 4 attempts, got %d", count)
+	}
+}
+
+func TestRetryStopsAtMaxAttempts(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	server.SetError(client.RequestSyncStatusPath, http.StatusServiceUnavailable, "node is busy", 0)
+
+	bc := client.NewStandardHttpClient(server.URL, client.WithRetry(3, time.Millisecond))
+	if _, err := bc.GetSyncStatus(context.Background()); err == nil {
+		t.Fatal("expected the request to fail once attempts ran out")
+	}
+	if count := server.RequestCount(client.RequestSyncStatusPath); count != 3 {
+		t.Errorf("expected 3 attempts, got %d", count)
+	}
+}
+
+func TestRetrySkipsClientErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusNotFound} {
+		server := clienttest.NewServer()
+		server.SetError(client.RequestSyncStatusPath, status, "bad request", 0)
+
+		bc := client.NewStandardHttpClient(server.URL, client.WithRetry(5, time.Millisecond))
+		if _, err := bc.GetSyncStatus(context.Background()); err == nil {
+			t.Errorf("HTTP %d: expected the request to fail", status)
+		}
+		if count := server.RequestCount(client.RequestSyncStatusPath); count != 1 {
+			t.Errorf("HTTP %d: expected 1 attempt, got %d", status, count)
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	"golang.org/x/sync/errgroup"
+)
+
+// Compute the signing domain for a domain type, fork version, and genesis validators root, as defined by compute_domain in the spec
+func ComputeDomain(domainType [4]byte, forkVersion []byte, genesisValidatorsRoot []byte) [32]byte {
+	var domain [32]byte
+	copy(domain[:], eth2types.Domain(domainType, forkVersion, genesisValidatorsRoot))
+	return domain
+}
+
+// Compute the root that a validator signs over for a message, as defined by compute_signing_root in the spec
+func computeSigningRoot(objectRoot [32]byte, domain [32]byte) ([32]byte, error) {
+	signingRoot := eth2.SigningRoot{
+		ObjectRoot: objectRoot[:],
+		Domain:     domain[:],
+	}
+	return signingRoot.HashTreeRoot()
+}
+
+// Get the root a validator signs over for a voluntary exit.
+// From Deneb on, exits are always signed with the Capella fork version (EIP-7044) so they stay valid across later forks;
+// before Deneb, the fork version in effect at the exit's epoch is used.
+func (c *StandardHttpClient) VoluntaryExitSigningRoot(ctx context.Context, message VoluntaryExitMessage) (common.Hash, error) {
+	ctx, cancel := c.methodContext(ctx, "VoluntaryExitSigningRoot")
+	defer cancel()
+
+	validatorIndex, err := strconv.ParseUint(message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("Could not compute voluntary exit signing root: invalid validator index '%s': %w", message.ValidatorIndex, err)
+	}
+	domain, err := c.getVoluntaryExitDomain(ctx, uint64(message.Epoch))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("Could not compute voluntary exit signing root: %w", err)
+	}
+
+	exit := eth2.VoluntaryExit{
+		Epoch:          uint64(message.Epoch),
+		ValidatorIndex: validatorIndex,
+	}
+	objectRoot, err := exit.HashTreeRoot()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("Could not compute voluntary exit signing root: %w", err)
+	}
+	signingRoot, err := computeSigningRoot(objectRoot, domain)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("Could not compute voluntary exit signing root: %w", err)
+	}
+	return signingRoot, nil
+}
+
+// Get the signing domain for a voluntary exit at an epoch
+func (c *StandardHttpClient) getVoluntaryExitDomain(ctx context.Context, epoch uint64) ([32]byte, error) {
+
+	// Data
+	var wg errgroup.Group
+	var eth2Config Eth2ConfigResponse
+	var genesis GenesisResponse
+	var fork ForkResponse
+
+	// Get eth2 config
+	wg.Go(func() error {
+		var err error
+		eth2Config, err = c.getEth2Config(ctx)
+		return err
+	})
+
+	// Get genesis
+	wg.Go(func() error {
+		var err error
+		genesis, err = c.getGenesis(ctx)
+		return err
+	})
+
+	// Get fork
+	wg.Go(func() error {
+		var err error
+		fork, err = c.getFork(ctx, "head")
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return [32]byte{}, err
+	}
+
+	// Before Deneb, use the fork version at the exit's epoch
+	if uint64(fork.Data.Epoch) < uint64(eth2Config.Data.DenebForkEpoch) {
+		forkVersion := fork.Data.CurrentVersion
+		if epoch < uint64(fork.Data.Epoch) {
+			forkVersion = fork.Data.PreviousVersion
+		}
+		return ComputeDomain(eth2types.DomainVoluntaryExit, forkVersion, genesis.Data.GenesisValidatorsRoot), nil
+	}
+
+	// From Deneb on, use the Capella fork version, which is the version Deneb replaced
+	forkSchedule, err := c.getForkSchedule(ctx)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	for _, scheduledFork := range forkSchedule.Data {
+		if scheduledFork.Epoch == eth2Config.Data.DenebForkEpoch && string(scheduledFork.PreviousVersion) != string(scheduledFork.CurrentVersion) {
+			return ComputeDomain(eth2types.DomainVoluntaryExit, scheduledFork.PreviousVersion, genesis.Data.GenesisValidatorsRoot), nil
+		}
+	}
+	return [32]byte{}, fmt.Errorf("the fork schedule does not have the Deneb fork at epoch %d", eth2Config.Data.DenebForkEpoch)
+}
+
+// Build a signed voluntary exit that's ready to submit.
+// The signing domain is derived from the node's fork and genesis data, so signer only has to sign the provided root with the validator's key.
+func (c *StandardHttpClient) BuildVoluntaryExit(ctx context.Context, validatorIndex string, epoch uint64, signer func(signingRoot [32]byte) ([]byte, error)) (VoluntaryExitRequest, error) {
+	message := VoluntaryExitMessage{
+		Epoch:          uinteger(epoch),
+		ValidatorIndex: validatorIndex,
+	}
+	signingRoot, err := c.VoluntaryExitSigningRoot(ctx, message)
+	if err != nil {
+		return VoluntaryExitRequest{}, err
+	}
+	signature, err := signer(signingRoot)
+	if err != nil {
+		return VoluntaryExitRequest{}, fmt.Errorf("Could not sign voluntary exit for validator %s: %w", validatorIndex, err)
+	}
+	if len(signature) != types.ValidatorSignatureLength {
+		return VoluntaryExitRequest{}, fmt.Errorf("Could not sign voluntary exit for validator %s: signature is %d bytes instead of %d", validatorIndex, len(signature), types.ValidatorSignatureLength)
+	}
+	return VoluntaryExitRequest{
+		Message:   message,
+		Signature: signature,
+	}, nil
+}
+
+// Get the root a validator's withdrawal key signs over for a BLS to execution change.
+// These are always signed with the genesis fork version, regardless of the current fork, so that they stay valid forever.
+func (c *StandardHttpClient) BLSToExecutionChangeSigningRoot(ctx context.Context, message BLSToExecutionChangeMessage) (common.Hash, error) {
+	ctx, cancel := c.methodContext(ctx, "BLSToExecutionChangeSigningRoot")
+	defer cancel()
+
+	validatorIndex, err := strconv.ParseUint(message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("Could not compute withdrawal credentials change signing root: invalid validator index '%s': %w", message.ValidatorIndex, err)
+	}
+	if len(message.FromBLSPubkey) != types.ValidatorPubkeyLength {
+		return common.Hash{}, fmt.Errorf("Could not compute withdrawal credentials change signing root: BLS pubkey is %d bytes instead of %d", len(message.FromBLSPubkey), types.ValidatorPubkeyLength)
+	}
+	if len(message.ToExecutionAddress) != common.AddressLength {
+		return common.Hash{}, fmt.Errorf("Could not compute withdrawal credentials change signing root: execution address is %d bytes instead of %d", len(message.ToExecutionAddress), common.AddressLength)
+	}
+	genesis, err := c.getGenesis(ctx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("Could not compute withdrawal credentials change signing root: %w", err)
+	}
+	domain := ComputeDomain(eth2types.DomainBlsToExecutionChange, genesis.Data.GenesisForkVersion, genesis.Data.GenesisValidatorsRoot)
+
+	change := eth2.WithdrawalCredentialsChange{
+		ValidatorIndex: validatorIndex,
+	}
+	copy(change.FromBLSPubkey[:], message.FromBLSPubkey)
+	copy(change.ToExecutionAddress[:], message.ToExecutionAddress)
+	objectRoot, err := change.HashTreeRoot()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("Could not compute withdrawal credentials change signing root: %w", err)
+	}
+	signingRoot, err := computeSigningRoot(objectRoot, domain)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("Could not compute withdrawal credentials change signing root: %w", err)
+	}
+	return signingRoot, nil
+}
+
+// Build a signed BLS to execution change that's ready to submit.
+// The signing domain is derived from the node's genesis data, so signer only has to sign the provided root with the validator's withdrawal key.
+func (c *StandardHttpClient) BuildBLSToExecutionChange(ctx context.Context, validatorIndex string, fromBLSPubkey []byte, toAddress common.Address, signer func(signingRoot [32]byte) ([]byte, error)) (BLSToExecutionChangeRequest, error) {
+	message := BLSToExecutionChangeMessage{
+		ValidatorIndex:     validatorIndex,
+		FromBLSPubkey:      fromBLSPubkey,
+		ToExecutionAddress: toAddress.Bytes(),
+	}
+	signingRoot, err := c.BLSToExecutionChangeSigningRoot(ctx, message)
+	if err != nil {
+		return BLSToExecutionChangeRequest{}, err
+	}
+	signature, err := signer(signingRoot)
+	if err != nil {
+		return BLSToExecutionChangeRequest{}, fmt.Errorf("Could not sign withdrawal credentials change for validator %s: %w", validatorIndex, err)
+	}
+	if len(signature) != types.ValidatorSignatureLength {
+		return BLSToExecutionChangeRequest{}, fmt.Errorf("Could not sign withdrawal credentials change for validator %s: signature is %d bytes instead of %d", validatorIndex, len(signature), types.ValidatorSignatureLength)
+	}
+	return BLSToExecutionChangeRequest{
+		Message:   message,
+		Signature: signature,
+	}, nil
+}
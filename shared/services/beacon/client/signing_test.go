@@ -0,0 +1,155 @@
+package client_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+// Mainnet genesis data and fork versions
+var (
+	mainnetGenesisValidatorsRoot = common.HexToHash("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95")
+	mainnetGenesisForkVersion    = []byte{0x00, 0x00, 0x00, 0x00}
+	mainnetAltairForkVersion     = []byte{0x01, 0x00, 0x00, 0x00}
+	mainnetBellatrixForkVersion  = []byte{0x02, 0x00, 0x00, 0x00}
+	mainnetCapellaForkVersion    = []byte{0x03, 0x00, 0x00, 0x00}
+	mainnetDenebForkVersion      = []byte{0x04, 0x00, 0x00, 0x00}
+	mainnetElectraForkVersion    = []byte{0x05, 0x00, 0x00, 0x00}
+)
+
+// A mainnet fork, as served by the fork and fork schedule routes
+type mainnetFork struct {
+	previousVersion []byte
+	currentVersion  []byte
+	epoch           uint64
+}
+
+var mainnetForkSchedule = []mainnetFork{
+	{previousVersion: mainnetGenesisForkVersion, currentVersion: mainnetGenesisForkVersion, epoch: 0},
+	{previousVersion: mainnetGenesisForkVersion, currentVersion: mainnetAltairForkVersion, epoch: 74240},
+	{previousVersion: mainnetAltairForkVersion, currentVersion: mainnetBellatrixForkVersion, epoch: 144896},
+	{previousVersion: mainnetBellatrixForkVersion, currentVersion: mainnetCapellaForkVersion, epoch: 194048},
+	{previousVersion: mainnetCapellaForkVersion, currentVersion: mainnetDenebForkVersion, epoch: 269568},
+	{previousVersion: mainnetDenebForkVersion, currentVersion: mainnetElectraForkVersion, epoch: 364032},
+}
+
+func (f mainnetFork) toData() map[string]string {
+	return map[string]string{
+		"previous_version": "0x" + hex.EncodeToString(f.previousVersion),
+		"current_version":  "0x" + hex.EncodeToString(f.currentVersion),
+		"epoch":            fmt.Sprint(f.epoch),
+	}
+}
+
+// Start a mock node with mainnet's genesis and fork schedule, whose head is in the provided fork
+func newMainnetServer(headFork mainnetFork) *clienttest.Server {
+	server := clienttest.NewServer()
+	server.SetGenesis(clienttest.Genesis{
+		Time:                  1606824023,
+		ForkVersion:           mainnetGenesisForkVersion,
+		GenesisValidatorsRoot: mainnetGenesisValidatorsRoot,
+	})
+	server.SetData(fmt.Sprintf(client.RequestForkPath, "head"), headFork.toData())
+	schedule := make([]map[string]string, len(mainnetForkSchedule))
+	for i, fork := range mainnetForkSchedule {
+		schedule[i] = fork.toData()
+	}
+	server.SetData(client.RequestForkSchedulePath, schedule)
+	return server
+}
+
+func TestComputeDomain(t *testing.T) {
+	tests := []struct {
+		name                  string
+		domainType            eth2types.DomainType
+		forkVersion           []byte
+		genesisValidatorsRoot []byte
+		expected              string
+	}{
+		// Deposits are signed without a genesis validators root; this is the well-known mainnet deposit domain
+		{name: "deposit", domainType: eth2types.DomainDeposit, forkVersion: mainnetGenesisForkVersion, genesisValidatorsRoot: make([]byte, 32), expected: "0x03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9"},
+
+		// The bytes after the domain type start with the fork digest, which mainnet publishes for each fork
+		{name: "genesis exit", domainType: eth2types.DomainVoluntaryExit, forkVersion: mainnetGenesisForkVersion, genesisValidatorsRoot: mainnetGenesisValidatorsRoot[:], expected: "0x04000000b5303f2a"},
+		{name: "altair exit", domainType: eth2types.DomainVoluntaryExit, forkVersion: mainnetAltairForkVersion, genesisValidatorsRoot: mainnetGenesisValidatorsRoot[:], expected: "0x04000000afcaaba0"},
+		{name: "bellatrix exit", domainType: eth2types.DomainVoluntaryExit, forkVersion: mainnetBellatrixForkVersion, genesisValidatorsRoot: mainnetGenesisValidatorsRoot[:], expected: "0x040000004a26c58b08add8089b75caa540848881a8d4f0af0be83417a85c0f45"},
+		{name: "capella exit", domainType: eth2types.DomainVoluntaryExit, forkVersion: mainnetCapellaForkVersion, genesisValidatorsRoot: mainnetGenesisValidatorsRoot[:], expected: "0x04000000bba4da96354c9f25476cf1bc69bf583a7f9e0af049305b62de676640"},
+		{name: "deneb exit", domainType: eth2types.DomainVoluntaryExit, forkVersion: mainnetDenebForkVersion, genesisValidatorsRoot: mainnetGenesisValidatorsRoot[:], expected: "0x040000006a95a1a9"},
+		{name: "bls to execution change", domainType: eth2types.DomainBlsToExecutionChange, forkVersion: mainnetGenesisForkVersion, genesisValidatorsRoot: mainnetGenesisValidatorsRoot[:], expected: "0x0a000000b5303f2ad2010d699a76c8e62350947421a3e4a979779642cfdb0f66"},
+	}
+	for _, test := range tests {
+		domain := client.ComputeDomain(test.domainType, test.forkVersion, test.genesisValidatorsRoot)
+		encoded := "0x" + hex.EncodeToString(domain[:])
+		if encoded[:len(test.expected)] != test.expected {
+			t.Errorf("%s: expected domain %s, got %s", test.name, test.expected, encoded)
+		}
+	}
+}
+
+// The expected signing roots were computed independently of this package's SSZ code, from the mainnet domains above
+func TestVoluntaryExitSigningRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		headFork mainnetFork
+		epoch    uint64
+		expected string
+	}{
+		// Before Deneb, the fork version at the exit's epoch is used
+		{name: "pre-Deneb, previous fork", headFork: mainnetForkSchedule[3], epoch: 190000, expected: "0x40e478c3ad089125e441a29bcb4aac19450ffa09a24cafeb8b9038dcfcecaba2"},
+		{name: "pre-Deneb, current fork", headFork: mainnetForkSchedule[3], epoch: 194048, expected: "0x01eb1b379e9c5ece9a101e276291bfb16b711a80dd3d3aebf2dc4830704ee6c3"},
+
+		// From Deneb on, the Capella fork version is always used (EIP-7044)
+		{name: "Deneb", headFork: mainnetForkSchedule[4], epoch: 300000, expected: "0x77ff2d2cdb53b32650ba2d83ae70c6c1e479f567c5f13c31879c5d1c60df3513"},
+		{name: "Electra", headFork: mainnetForkSchedule[5], epoch: 300000, expected: "0x77ff2d2cdb53b32650ba2d83ae70c6c1e479f567c5f13c31879c5d1c60df3513"},
+		{name: "Deneb, old exit epoch", headFork: mainnetForkSchedule[4], epoch: 194048, expected: "0x01eb1b379e9c5ece9a101e276291bfb16b711a80dd3d3aebf2dc4830704ee6c3"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newMainnetServer(test.headFork)
+			defer server.Close()
+
+			bc := client.NewStandardHttpClient(server.URL)
+			var signingRoot common.Hash
+			_, err := bc.BuildVoluntaryExit(context.Background(), "123456", test.epoch, func(root [32]byte) ([]byte, error) {
+				signingRoot = root
+				return make([]byte, types.ValidatorSignatureLength), nil
+			})
+			if err != nil {
+				t.Fatalf("error building exit: %s", err.Error())
+			}
+			if signingRoot != common.HexToHash(test.expected) {
+				t.Errorf("expected signing root %s, got %s", test.expected, signingRoot.Hex())
+			}
+		})
+	}
+}
+
+func TestBLSToExecutionChangeSigningRoot(t *testing.T) {
+	// BLS changes are signed with the genesis fork version no matter which fork the node is in
+	for _, headFork := range mainnetForkSchedule[3:] {
+		server := newMainnetServer(headFork)
+
+		bc := client.NewStandardHttpClient(server.URL)
+		signingRoot, err := bc.BLSToExecutionChangeSigningRoot(context.Background(), client.BLSToExecutionChangeMessage{
+			ValidatorIndex:     "123456",
+			FromBLSPubkey:      common.FromHex("0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"),
+			ToExecutionAddress: common.FromHex("0xd8da6bf26964af9d7eed9e03e53415d37aa96045"),
+		})
+		server.Close()
+		if err != nil {
+			t.Fatalf("error computing signing root: %s", err.Error())
+		}
+		expected := common.HexToHash("0xa0a6192190ff8953fdb59eceb48765e76766f6d142701dc4671269624dd99ca8")
+		if signingRoot != expected {
+			t.Errorf("head fork %x: expected signing root %s, got %s", headFork.currentVersion, expected.Hex(), signingRoot.Hex())
+		}
+	}
+}
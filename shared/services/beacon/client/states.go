@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// How many slots before the finalized checkpoint to probe for a historical state; about 4.5 days on mainnet, which is well past what non-archive nodes keep
+const historicalStateProbeDistance uint64 = 32768
+
+// Phrases in error messages that nodes use when they don't have a state
+var missingStatePhrases = []string{"not found", "not available", "unavailable", "pruned", "missing", "unknown"}
+
+// Get an error wrapping beacon.ErrStateUnavailable if a response to a state route says the node doesn't have the state, or nil otherwise.
+// A 404 always means the state wasn't found; some nodes use 400 or 500 for pruned states instead, so those are checked by their message.
+func stateUnavailableError(stateId string, status int, responseBody []byte) error {
+	switch status {
+	case http.StatusNotFound:
+	case http.StatusBadRequest, http.StatusInternalServerError:
+		if !reportsMissingState(responseBody) {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return fmt.Errorf("state %s: %w; response body: '%s'", stateId, beacon.ErrStateUnavailable, string(responseBody))
+}
+
+// Check if an error response's message says that the requested state doesn't exist on the node
+func reportsMissingState(responseBody []byte) bool {
+	return errorMessageReports(responseBody, "state", missingStatePhrases)
+}
+
+// Check if an error response's message mentions the subject along with any of the phrases
+func errorMessageReports(responseBody []byte, subject string, phrases []string) bool {
+	var response IndexedErrorResponse
+	message := string(responseBody)
+	if err := json.Unmarshal(responseBody, &response); err == nil && response.Message != "" {
+		message = response.Message
+	}
+	// Lighthouse uses upper-case codes like NOT_FOUND, so match them as plain words
+	message = strings.ReplaceAll(strings.ToLower(message), "_", " ")
+	if !strings.Contains(message, subject) {
+		return false
+	}
+	for _, phrase := range phrases {
+		if strings.Contains(message, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check if the node keeps historical states (i.e. it's an archive node), by requesting the root of a state well before the finalized checkpoint.
+// The result is cached after the first successful probe.
+func (c *StandardHttpClient) SupportsHistoricalStates(ctx context.Context) (bool, error) {
+	ctx, cancel := c.methodContext(ctx, "SupportsHistoricalStates")
+	defer cancel()
+
+	c.historicalStatesLock.Lock()
+	defer c.historicalStatesLock.Unlock()
+	if c.historicalStates != nil {
+		return *c.historicalStates, nil
+	}
+
+	// Get the slot to probe
+	eth2Config, err := c.getEth2Config(ctx)
+	if err != nil {
+		return false, err
+	}
+	finality, err := c.getFinalityCheckpoints(ctx, "head")
+	if err != nil {
+		return false, err
+	}
+	finalizedSlot := uint64(finality.Data.Finalized.Epoch) * uint64(eth2Config.Data.SlotsPerEpoch)
+	probeSlot := uint64(1)
+	if finalizedSlot > historicalStateProbeDistance+probeSlot {
+		probeSlot = finalizedSlot - historicalStateProbeDistance
+	}
+
+	// Probe the state
+	supported, err := c.isStateAvailable(ctx, probeSlot)
+	if err != nil {
+		return false, fmt.Errorf("Could not check for historical states: %w", err)
+	}
+	c.historicalStates = &supported
+	return supported, nil
+}
+
+// Get the earliest slot the node has the state for.
+// Nodes that were checkpoint synced (and haven't reconstructed their history) can't serve states from before their checkpoint,
+// so this bounds how far back state queries can go. It's found by binary search over the state roots up to the finalized checkpoint,
+// which takes a few dozen requests on mainnet; the slot moves forward as nodes prune, so it isn't cached.
+func (c *StandardHttpClient) GetEarliestAvailableSlot(ctx context.Context) (uint64, error) {
+	ctx, cancel := c.methodContext(ctx, "GetEarliestAvailableSlot")
+	defer cancel()
+
+	// Get the finalized slot, which every node has the state for
+	eth2Config, err := c.getEth2Config(ctx)
+	if err != nil {
+		return 0, err
+	}
+	finality, err := c.getFinalityCheckpoints(ctx, "head")
+	if err != nil {
+		return 0, err
+	}
+	finalizedSlot := uint64(finality.Data.Finalized.Epoch) * uint64(eth2Config.Data.SlotsPerEpoch)
+
+	// Search for the earliest available state after genesis; many nodes keep the genesis state even if they pruned the rest
+	low, high := uint64(1), finalizedSlot
+	for low < high {
+		mid := low + (high-low)/2
+		available, err := c.isStateAvailable(ctx, mid)
+		if err != nil {
+			return 0, fmt.Errorf("Could not get the earliest available slot: %w", err)
+		}
+		if available {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	// Include genesis if the node has the whole history
+	if low <= 1 {
+		available, err := c.isStateAvailable(ctx, 0)
+		if err != nil {
+			return 0, fmt.Errorf("Could not get the earliest available slot: %w", err)
+		}
+		if available {
+			return 0, nil
+		}
+	}
+	return low, nil
+}
+
+// Check if the node has the state at a slot
+func (c *StandardHttpClient) isStateAvailable(ctx context.Context, slot uint64) (bool, error) {
+	stateId := strconv.FormatUint(slot, 10)
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestStateRootPath, stateId))
+	if err != nil {
+		return false, err
+	}
+	if status == http.StatusOK {
+		return true, nil
+	}
+	if stateUnavailableError(stateId, status, responseBody) != nil {
+		return false, nil
+	}
+	return false, fmt.Errorf("HTTP status %d; response body: '%s'", status, string(responseBody))
+}
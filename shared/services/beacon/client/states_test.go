@@ -0,0 +1,107 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+// Error responses for a state that the node doesn't have, or for a bad request that has nothing to do with a missing state
+var stateErrorTests = []struct {
+	name        string
+	status      int
+	message     string
+	unavailable bool
+}{
+	{name: "404", status: http.StatusNotFound, message: "Not found", unavailable: true},
+	{name: "Lighthouse 400", status: http.StatusBadRequest, message: "NOT_FOUND: beacon state at slot 1000", unavailable: true},
+	{name: "Lighthouse 500", status: http.StatusInternalServerError, message: "UNHANDLED_ERROR: BeaconChainError(MissingBeaconState(0x1d2b...9e0c))", unavailable: true},
+	{name: "Teku 400", status: http.StatusBadRequest, message: "State not found", unavailable: true},
+	{name: "Teku 500", status: http.StatusInternalServerError, message: "Requested historical state is not available", unavailable: true},
+	{name: "Prysm 400", status: http.StatusBadRequest, message: "Invalid state ID: could not get state: state not found in the last 8192 state roots", unavailable: true},
+	{name: "Prysm 500", status: http.StatusInternalServerError, message: "Could not get state: state not found in the last 8192 state roots", unavailable: true},
+	{name: "invalid state ID", status: http.StatusBadRequest, message: "BAD_REQUEST: invalid state ID: foo", unavailable: false},
+	{name: "unrelated 400", status: http.StatusBadRequest, message: "Invalid validator status: bar", unavailable: false},
+	{name: "503", status: http.StatusServiceUnavailable, message: "Beacon node is currently syncing and not serving requests: state unavailable", unavailable: false},
+}
+
+func TestGetValidatorsStateUnavailable(t *testing.T) {
+	for _, test := range stateErrorTests {
+		t.Run(test.name, func(t *testing.T) {
+			server := clienttest.NewServer()
+			defer server.Close()
+			server.SetError(fmt.Sprintf(client.RequestValidatorsPath, "1000"), test.status, test.message, 0)
+
+			bc := client.NewStandardHttpClient(server.URL)
+			_, err := bc.GetValidatorsByStatus(context.Background(), "1000", nil)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if errors.Is(err, beacon.ErrStateUnavailable) != test.unavailable {
+				t.Errorf("expected errors.Is(err, ErrStateUnavailable) to be %t, got error: %s", test.unavailable, err.Error())
+			}
+		})
+	}
+}
+
+// GetValidator has to tell a missing state from a missing validator, since both can come back as a 404
+func TestGetValidatorStateUnavailable(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		message     string
+		unavailable bool
+		notFound    bool
+	}{
+		{name: "Lighthouse missing state", status: http.StatusNotFound, message: "NOT_FOUND: beacon state at slot 1000", unavailable: true},
+		{name: "Lighthouse missing validator", status: http.StatusNotFound, message: "NOT_FOUND: unknown validator: 5", notFound: true},
+		{name: "Teku missing validator", status: http.StatusNotFound, message: "Not found", notFound: true},
+		{name: "Prysm missing state", status: http.StatusInternalServerError, message: "Could not get state: state not found in the last 8192 state roots", unavailable: true},
+		{name: "Prysm missing validator", status: http.StatusNotFound, message: "Could not find validator", notFound: true},
+		{name: "invalid state ID", status: http.StatusBadRequest, message: "BAD_REQUEST: invalid state ID: foo"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := clienttest.NewServer()
+			defer server.Close()
+			server.SetError(fmt.Sprintf(client.RequestValidatorPath, "1000", "5"), test.status, test.message, 0)
+
+			bc := client.NewStandardHttpClient(server.URL)
+			_, err := bc.GetValidator(context.Background(), "1000", "5")
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if errors.Is(err, beacon.ErrStateUnavailable) != test.unavailable {
+				t.Errorf("expected errors.Is(err, ErrStateUnavailable) to be %t, got error: %s", test.unavailable, err.Error())
+			}
+			if errors.Is(err, beacon.ErrValidatorNotFound) != test.notFound {
+				t.Errorf("expected errors.Is(err, ErrValidatorNotFound) to be %t, got error: %s", test.notFound, err.Error())
+			}
+		})
+	}
+}
+
+func TestGetValidatorsStreamedFieldError(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	server.SetResponse(fmt.Sprintf(client.RequestValidatorsPath, "head"), clienttest.Response{
+		Status: http.StatusOK,
+		Body: []byte(`{"execution_optimistic": false, "data": [` +
+			`{"index": "0", "balance": "32000000000", "status": "active_ongoing", "validator": {"pubkey": "0x` + strings.Repeat("aa", 48) + `", "withdrawal_credentials": "0x` + strings.Repeat("bb", 32) + `"}},` +
+			`{"index": "1", "balance": "32000000000", "status": "active_ongoing", "validator": {"pubkey": "0x` + strings.Repeat("aa", 48) + `", "withdrawal_credentials": "0x` + strings.Repeat("bb", 31) + `b"}}]}`),
+	})
+	bc := client.NewStandardHttpClient(server.URL)
+
+	_, err := bc.GetValidatorsStreamed(context.Background(), "head", nil, 0)
+	expected := "Could not decode validator 1: validator.withdrawal_credentials: invalid hex value"
+	if err == nil || !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected an error containing %q, got %v", expected, err)
+	}
+}
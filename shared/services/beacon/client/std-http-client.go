@@ -2,22 +2,28 @@ package client
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
+	"github.com/google/uuid"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	"github.com/rocket-pool/rocketpool-go/types"
-	eth2types "github.com/wealdtech/go-eth2-types/v2"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/rocket-pool/smartnode/shared"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/utils/eth2"
 	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
@@ -25,38 +31,155 @@ import (
 
 // Config
 const (
-	RequestUrlFormat   = "%s%s"
-	RequestContentType = "application/json"
+	RequestUrlFormat              = "%s%s"
+	RequestContentType            = "application/json"
+	RequestSszContentType         = "application/octet-stream"
+	RequestEventStreamContentType = "text/event-stream"
+	ConsensusVersionHeader        = "Eth-Consensus-Version"
+	RequestIdHeader               = "X-Request-ID"
+	DefaultUserAgent              = "smartnode/" + shared.RocketPoolVersion
 
 	RequestSyncStatusPath                  = "/eth/v1/node/syncing"
+	RequestHealthPath                      = "/eth/v1/node/health"
+	RequestNodeVersionPath                 = "/eth/v1/node/version"
+	RequestNodeIdentityPath                = "/eth/v1/node/identity"
+	RequestPeerCountPath                   = "/eth/v1/node/peer_count"
+	RequestPeersPath                       = "/eth/v1/node/peers"
 	RequestEth2ConfigPath                  = "/eth/v1/config/spec"
 	RequestEth2DepositContractMethod       = "/eth/v1/config/deposit_contract"
+	RequestForkSchedulePath                = "/eth/v1/config/fork_schedule"
 	RequestGenesisPath                     = "/eth/v1/beacon/genesis"
+	RequestDepositSnapshotPath             = "/eth/v1/beacon/deposit_snapshot"
+	RequestStateRootPath                   = "/eth/v1/beacon/states/%s/root"
 	RequestCommitteePath                   = "/eth/v1/beacon/states/%s/committees"
+	RequestSyncCommitteesPath              = "/eth/v1/beacon/states/%s/sync_committees"
 	RequestFinalityCheckpointsPath         = "/eth/v1/beacon/states/%s/finality_checkpoints"
 	RequestForkPath                        = "/eth/v1/beacon/states/%s/fork"
+	RequestRandaoPath                      = "/eth/v1/beacon/states/%s/randao"
 	RequestValidatorsPath                  = "/eth/v1/beacon/states/%s/validators"
+	RequestValidatorPath                   = "/eth/v1/beacon/states/%s/validators/%s"
+	RequestValidatorBalancesPath           = "/eth/v1/beacon/states/%s/validator_balances"
+	RequestPendingDepositsPath             = "/eth/v1/beacon/states/%s/pending_deposits"
+	RequestDebugBeaconStatePath            = "/eth/v2/debug/beacon/states/%s"
 	RequestVoluntaryExitPath               = "/eth/v1/beacon/pool/voluntary_exits"
+	RequestAttesterSlashingsPath           = "/eth/v1/beacon/pool/attester_slashings"
+	RequestProposerSlashingsPath           = "/eth/v1/beacon/pool/proposer_slashings"
+	RequestPoolAttestationsPath            = "/eth/v1/beacon/pool/attestations"
+	RequestPoolSyncCommitteesPath          = "/eth/v1/beacon/pool/sync_committees"
 	RequestAttestationsPath                = "/eth/v1/beacon/blocks/%s/attestations"
 	RequestBeaconBlockPath                 = "/eth/v2/beacon/blocks/%s"
+	RequestBlockHeadersPath                = "/eth/v1/beacon/headers"
+	RequestBlockHeaderPath                 = "/eth/v1/beacon/headers/%s"
 	RequestValidatorSyncDuties             = "/eth/v1/validator/duties/sync/%s"
 	RequestValidatorProposerDuties         = "/eth/v1/validator/duties/proposer/%s"
+	RequestValidatorAttesterDuties         = "/eth/v1/validator/duties/attester/%s"
+	RequestValidatorLivenessPath           = "/eth/v1/validator/liveness/%s"
+	RequestAggregateAttestationPath        = "/eth/v1/validator/aggregate_attestation"
+	RequestAttestationDataPath             = "/eth/v1/validator/attestation_data"
+	RequestProduceBlockPath                = "/eth/v2/validator/blocks/%s"
+	RequestProduceBlindedBlockPath         = "/eth/v1/validator/blinded_blocks/%s"
+	RequestFeeRecipientPath                = "/eth/v1/validator/%s/feerecipient"
+	RequestPrepareBeaconProposerPath       = "/eth/v1/validator/prepare_beacon_proposer"
+	RequestRegisterValidatorPath           = "/eth/v1/validator/register_validator"
 	RequestWithdrawalCredentialsChangePath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	RequestBlobSidecarsPath                = "/eth/v1/beacon/blob_sidecars/%s"
+	RequestEventsPath                      = "/eth/v1/events?topics=%s"
+	RequestExpectedWithdrawalsPath         = "/eth/v1/builder/states/%s/expected_withdrawals"
+	RequestBlockRewardsPath                = "/eth/v1/beacon/rewards/blocks/%s"
+	RequestAttestationRewardsPath          = "/eth/v1/beacon/rewards/attestations/%s"
+	RequestSyncCommitteeRewardsPath        = "/eth/v1/beacon/rewards/sync_committee/%s"
+	RequestSyncCommitteeSubscriptionsPath  = "/eth/v1/validator/sync_committee_subscriptions"
+	RequestCommitteeSubscriptionsPath      = "/eth/v1/validator/beacon_committee_subscriptions"
 
 	MaxRequestValidatorsCount     = 600
+	MaxRegistrationsCount         = 500  // Keeps registration batches well under the common 1MB body size limit
+	MaxValidatorsQueryLength      = 6144 // Longer validator queries are sent as POST bodies, since many nodes cap URLs at 8KB
+	SyncCommitteeSize             = 512  // The SYNC_COMMITTEE_SIZE preset value, which is the same on every public network
 	threadLimit               int = 12
 )
 
 // Beacon client using the standard Beacon HTTP REST API (https://ethereum.github.io/beacon-APIs/)
 type StandardHttpClient struct {
 	providerAddress string
+
+	// SSZ support for getting the full validator set from the Beacon state
+	useSszValidators         bool
+	sszValidatorsUnsupported atomic.Bool
+
+	// POST support for the validators route
+	postValidatorsUnsupported atomic.Bool
+
+	// Retry policy for GET requests
+	retry retryPolicy
+
+	// Timeouts for individual methods, by name
+	methodTimeouts map[string]time.Duration
+
+	// Called after every request, for metrics
+	requestObserver func(RequestInfo)
+
+	// Called with every buffered response body, for debugging decoding problems
+	rawResponseCallback func(RawResponse)
+
+	// Check versioned responses against their consensus version
+	strictVersionChecks bool
+
+	// Debug logging for requests
+	logger Logger
+
+	// Sent with every request so node operators can identify the client's traffic
+	userAgent string
+
+	// Custom headers sent with every request, such as authorization for a proxy
+	headers http.Header
+
+	// The HTTP client requests are sent with, and the settings for its transport
+	httpClient       *http.Client
+	tlsConfig        *tls.Config
+	transportOptions TransportOptions
+
+	// Optional pacing of outgoing requests
+	rateLimiter *rateLimiter
+
+	// The node's implementation, detected when a client-specific fallback is needed
+	implementation     string
+	implementationLock sync.Mutex
+
+	// The network's config and genesis never change, so they're only fetched once
+	eth2Config     *Eth2ConfigResponse
+	eth2ConfigLock sync.Mutex
+	genesis        *GenesisResponse
+	genesisLock    sync.Mutex
+
+	// Whether the node keeps historical states, once it's been probed
+	historicalStates     *bool
+	historicalStatesLock sync.Mutex
+
+	// The last finalized checkpoint seen by CheckFinalizedCheckpoint
+	lastFinalized     *beacon.Checkpoint
+	lastFinalizedLock sync.Mutex
+
+	// Optional cache of blocks by slot, and the latest finalized epoch seen, which bounds what's safe to cache
+	blockCache              *blockCache
+	finalizedEpoch          atomic.Uint64
+	finalizedEpochRefreshed atomic.Int64
+
+	// Validator indices never change once assigned, so they're cached by pubkey
+	validatorIndices     map[string]string
+	validatorIndicesLock sync.Mutex
 }
 
 // Create a new client instance
-func NewStandardHttpClient(providerAddress string) *StandardHttpClient {
-	return &StandardHttpClient{
+func NewStandardHttpClient(providerAddress string, opts ...StandardHttpClientOption) *StandardHttpClient {
+	client := &StandardHttpClient{
 		providerAddress: providerAddress,
+		userAgent:       DefaultUserAgent,
 	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	client.httpClient = client.buildHttpClient()
+	return client
 }
 
 // Close the client connection
@@ -64,16 +187,40 @@ func (c *StandardHttpClient) Close() error {
 	return nil
 }
 
+// Clear the cached Eth2 config and genesis, so they're fetched from the node again on next use
+func (c *StandardHttpClient) RefreshConfig() {
+	c.eth2ConfigLock.Lock()
+	c.eth2Config = nil
+	c.eth2ConfigLock.Unlock()
+	c.genesisLock.Lock()
+	c.genesis = nil
+	c.genesisLock.Unlock()
+}
+
+// Get the slot of the node's head block.
+// This only needs the sync status, so it's cheaper than GetBeaconHead for polling.
+func (c *StandardHttpClient) GetHeadSlot(ctx context.Context) (uint64, error) {
+	ctx, cancel := c.methodContext(ctx, "GetHeadSlot")
+	defer cancel()
+	syncStatus, err := c.getSyncStatus(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(syncStatus.Data.HeadSlot), nil
+}
+
 // Get the client's process configuration type
 func (c *StandardHttpClient) GetClientType() (beacon.BeaconClientType, error) {
 	return beacon.SplitProcess, nil
 }
 
 // Get the node's sync status
-func (c *StandardHttpClient) GetSyncStatus() (beacon.SyncStatus, error) {
+func (c *StandardHttpClient) GetSyncStatus(ctx context.Context) (beacon.SyncStatus, error) {
+	ctx, cancel := c.methodContext(ctx, "GetSyncStatus")
+	defer cancel()
 
 	// Get sync status
-	syncStatus, err := c.getSyncStatus()
+	syncStatus, err := c.getSyncStatus(ctx)
 	if err != nil {
 		return beacon.SyncStatus{}, err
 	}
@@ -83,14 +230,135 @@ func (c *StandardHttpClient) GetSyncStatus() (beacon.SyncStatus, error) {
 
 	// Return response
 	return beacon.SyncStatus{
-		Syncing:  syncStatus.Data.IsSyncing,
-		Progress: progress,
+		Syncing:      syncStatus.Data.IsSyncing,
+		Progress:     progress,
+		IsOptimistic: syncStatus.Data.IsOptimistic,
+		ElOffline:    syncStatus.Data.ElOffline,
+	}, nil
+
+}
+
+// Get the node's health. If syncingStatus is not zero, the node is asked to report that it's syncing with that HTTP status code
+// instead of the default (206). It can't be 200 or 503, since those already mean healthy and unhealthy.
+func (c *StandardHttpClient) GetHealth(ctx context.Context, syncingStatus int) (beacon.HealthStatus, error) {
+	ctx, cancel := c.methodContext(ctx, "GetHealth")
+	defer cancel()
+
+	if syncingStatus == http.StatusOK || syncingStatus == http.StatusServiceUnavailable {
+		return beacon.HealthStatus_Unhealthy, fmt.Errorf("Could not get node health: syncing status %d is indistinguishable from a healthy or unhealthy node", syncingStatus)
+	}
+	requestPath := RequestHealthPath
+	if syncingStatus != 0 {
+		requestPath += fmt.Sprintf("?syncing_status=%d", syncingStatus)
+	}
+
+	// An unhealthy response is an answer in itself, so this deliberately skips the retry policy
+	response, err := c.sendGetRequestOnce(ctx, requestPath, "")
+	if err != nil {
+		return beacon.HealthStatus_Unhealthy, fmt.Errorf("Could not get node health: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return beacon.HealthStatus_Healthy, nil
+	case http.StatusServiceUnavailable:
+		return beacon.HealthStatus_Unhealthy, nil
+	case syncingStatus, http.StatusPartialContent:
+		return beacon.HealthStatus_Syncing, nil
+	}
+	body, _ := io.ReadAll(response.Body)
+	return beacon.HealthStatus_Unhealthy, fmt.Errorf("Could not get node health: HTTP status %d; response body: '%s'", response.StatusCode, string(body))
+}
+
+// Get the node's version, along with the implementation and version parsed from it
+func (c *StandardHttpClient) GetNodeVersion(ctx context.Context) (beacon.NodeVersion, error) {
+	ctx, cancel := c.methodContext(ctx, "GetNodeVersion")
+	defer cancel()
+	version, err := c.getNodeVersion(ctx)
+	if err != nil {
+		return beacon.NodeVersion{}, err
+	}
+	return parseNodeVersion(version.Data.Version), nil
+}
+
+// Get the node's network identity, including its ENR and subnet subscriptions
+func (c *StandardHttpClient) GetNodeIdentity(ctx context.Context) (beacon.NodeIdentity, error) {
+	ctx, cancel := c.methodContext(ctx, "GetNodeIdentity")
+	defer cancel()
+
+	// Get the identity
+	identity, err := c.getNodeIdentity(ctx)
+	if err != nil {
+		return beacon.NodeIdentity{}, err
+	}
+
+	// Return response
+	return beacon.NodeIdentity{
+		PeerID:             identity.Data.PeerID,
+		Enr:                identity.Data.Enr,
+		P2PAddresses:       identity.Data.P2PAddresses,
+		DiscoveryAddresses: identity.Data.DiscoveryAddresses,
+		SeqNumber:          uint64(identity.Data.Metadata.SeqNumber),
+		Attnets:            identity.Data.Metadata.Attnets,
+		Syncnets:           identity.Data.Metadata.Syncnets,
+	}, nil
+
+}
+
+// Get the number of peers the node has in each connection state
+func (c *StandardHttpClient) GetPeerCount(ctx context.Context) (beacon.PeerCount, error) {
+	ctx, cancel := c.methodContext(ctx, "GetPeerCount")
+	defer cancel()
+
+	// Get the peer count
+	peerCount, err := c.getPeerCount(ctx)
+	if err != nil {
+		return beacon.PeerCount{}, err
+	}
+
+	// Return response
+	return beacon.PeerCount{
+		Disconnected:  uint64(peerCount.Data.Disconnected),
+		Connecting:    uint64(peerCount.Data.Connecting),
+		Connected:     uint64(peerCount.Data.Connected),
+		Disconnecting: uint64(peerCount.Data.Disconnecting),
 	}, nil
 
 }
 
+// Get the node's peers, optionally filtered by connection state and direction
+func (c *StandardHttpClient) GetPeers(ctx context.Context, states []string, directions []string) ([]beacon.Peer, error) {
+	ctx, cancel := c.methodContext(ctx, "GetPeers")
+	defer cancel()
+
+	// Get the peers
+	response, err := c.getPeers(ctx, states, directions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	peers := make([]beacon.Peer, len(response.Data))
+	for i, peer := range response.Data {
+		peers[i] = beacon.Peer{
+			PeerID:    peer.PeerID,
+			Enr:       peer.Enr,
+			Address:   peer.LastSeenP2PAddress,
+			State:     peer.State,
+			Direction: peer.Direction,
+		}
+	}
+	return peers, nil
+
+}
+
 // Get the eth2 config
-func (c *StandardHttpClient) GetEth2Config() (beacon.Eth2Config, error) {
+func (c *StandardHttpClient) GetEth2Config(ctx context.Context) (beacon.Eth2Config, error) {
+	ctx, cancel := c.methodContext(ctx, "GetEth2Config")
+	defer cancel()
 
 	// Data
 	var wg errgroup.Group
@@ -100,14 +368,14 @@ func (c *StandardHttpClient) GetEth2Config() (beacon.Eth2Config, error) {
 	// Get eth2 config
 	wg.Go(func() error {
 		var err error
-		eth2Config, err = c.getEth2Config()
+		eth2Config, err = c.getEth2Config(ctx)
 		return err
 	})
 
 	// Get genesis
 	wg.Go(func() error {
 		var err error
-		genesis, err = c.getGenesis()
+		genesis, err = c.getGenesis(ctx)
 		return err
 	})
 
@@ -126,15 +394,32 @@ func (c *StandardHttpClient) GetEth2Config() (beacon.Eth2Config, error) {
 		SlotsPerEpoch:                uint64(eth2Config.Data.SlotsPerEpoch),
 		SecondsPerEpoch:              uint64(eth2Config.Data.SecondsPerSlot * eth2Config.Data.SlotsPerEpoch),
 		EpochsPerSyncCommitteePeriod: uint64(eth2Config.Data.EpochsPerSyncCommitteePeriod),
+
+		ShardCommitteePeriod:             uint64(eth2Config.Data.ShardCommitteePeriod),
+		MinValidatorWithdrawabilityDelay: uint64(eth2Config.Data.MinValidatorWithdrawabilityDelay),
+		ChurnLimitQuotient:               uint64(eth2Config.Data.ChurnLimitQuotient),
+		MinPerEpochChurnLimit:            uint64(eth2Config.Data.MinPerEpochChurnLimit),
+		MaxPerEpochActivationChurnLimit:  uint64(eth2Config.Data.MaxPerEpochActivationChurnLimit),
+		MaxEffectiveBalance:              uint64(eth2Config.Data.MaxEffectiveBalance),
+		EjectionBalance:                  uint64(eth2Config.Data.EjectionBalance),
+
+		EffectiveBalanceIncrement:           uint64(eth2Config.Data.EffectiveBalanceIncrement),
+		MinPerEpochChurnLimitElectra:        uint64(eth2Config.Data.MinPerEpochChurnLimitElectra),
+		MaxPerEpochActivationExitChurnLimit: uint64(eth2Config.Data.MaxPerEpochActivationExitChurnLimit),
+
+		DenebForkEpoch:   uint64(eth2Config.Data.DenebForkEpoch),
+		ElectraForkEpoch: uint64(eth2Config.Data.ElectraForkEpoch),
 	}, nil
 
 }
 
 // Get the eth2 deposit contract info
-func (c *StandardHttpClient) GetEth2DepositContract() (beacon.Eth2DepositContract, error) {
+func (c *StandardHttpClient) GetEth2DepositContract(ctx context.Context) (beacon.Eth2DepositContract, error) {
+	ctx, cancel := c.methodContext(ctx, "GetEth2DepositContract")
+	defer cancel()
 
 	// Get the deposit contract
-	depositContract, err := c.getEth2DepositContract()
+	depositContract, err := c.getEth2DepositContract(ctx)
 	if err != nil {
 		return beacon.Eth2DepositContract{}, err
 	}
@@ -146,25 +431,90 @@ func (c *StandardHttpClient) GetEth2DepositContract() (beacon.Eth2DepositContrac
 	}, nil
 }
 
-// Get the beacon head
-func (c *StandardHttpClient) GetBeaconHead() (beacon.BeaconHead, error) {
+// Get the network's fork schedule, in ascending epoch order as returned by the node
+func (c *StandardHttpClient) GetForkSchedule(ctx context.Context) ([]beacon.Fork, error) {
+	ctx, cancel := c.methodContext(ctx, "GetForkSchedule")
+	defer cancel()
+
+	// Get the fork schedule
+	forkSchedule, err := c.getForkSchedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	forks := make([]beacon.Fork, len(forkSchedule.Data))
+	for i, fork := range forkSchedule.Data {
+		forks[i] = beacon.Fork{
+			PreviousVersion: fork.PreviousVersion,
+			CurrentVersion:  fork.CurrentVersion,
+			Epoch:           uint64(fork.Epoch),
+		}
+	}
+	return forks, nil
+
+}
+
+// Get the finalized deposit tree snapshot, which execution clients can use to bootstrap their deposit tree quickly.
+// Returns false if the node doesn't have a snapshot available.
+func (c *StandardHttpClient) GetDepositSnapshot(ctx context.Context) (beacon.DepositSnapshot, bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetDepositSnapshot")
+	defer cancel()
+
+	// Get the deposit snapshot
+	snapshot, exists, err := c.getDepositSnapshot(ctx)
+	if err != nil {
+		return beacon.DepositSnapshot{}, false, err
+	}
+	if !exists {
+		return beacon.DepositSnapshot{}, false, nil
+	}
+
+	// Return response
+	finalized := make([]common.Hash, len(snapshot.Data.Finalized))
+	for i, hash := range snapshot.Data.Finalized {
+		finalized[i] = common.BytesToHash(hash)
+	}
+	return beacon.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          common.BytesToHash(snapshot.Data.DepositRoot),
+		DepositCount:         uint64(snapshot.Data.DepositCount),
+		ExecutionBlockHash:   common.BytesToHash(snapshot.Data.ExecutionBlockHash),
+		ExecutionBlockHeight: uint64(snapshot.Data.ExecutionBlockHeight),
+	}, true, nil
+
+}
+
+// Get the beacon head, including the head slot and finality checkpoints.
+// The requests are made concurrently, and the config is cached after the first call, so this is a single round trip.
+func (c *StandardHttpClient) GetBeaconHead(ctx context.Context) (beacon.BeaconHead, error) {
+	ctx, cancel := c.methodContext(ctx, "GetBeaconHead")
+	defer cancel()
 
 	// Data
 	var wg errgroup.Group
 	var eth2Config beacon.Eth2Config
 	var finalityCheckpoints FinalityCheckpointsResponse
+	var syncStatus SyncStatusResponse
 
 	// Get eth2 config
 	wg.Go(func() error {
 		var err error
-		eth2Config, err = c.GetEth2Config()
+		eth2Config, err = c.GetEth2Config(ctx)
 		return err
 	})
 
 	// Get finality checkpoints
 	wg.Go(func() error {
 		var err error
-		finalityCheckpoints, err = c.getFinalityCheckpoints("head")
+		finalityCheckpoints, err = c.getFinalityCheckpoints(ctx, "head")
+		return err
+	})
+
+	// Get the head slot
+	wg.Go(func() error {
+		var err error
+		syncStatus, err = c.getSyncStatus(ctx)
 		return err
 	})
 
@@ -175,27 +525,59 @@ func (c *StandardHttpClient) GetBeaconHead() (beacon.BeaconHead, error) {
 
 	// Return response
 	return beacon.BeaconHead{
+		Slot:                   uint64(syncStatus.Data.HeadSlot),
 		Epoch:                  eth2.EpochAt(eth2Config, uint64(time.Now().Unix())),
 		FinalizedEpoch:         uint64(finalityCheckpoints.Data.Finalized.Epoch),
 		JustifiedEpoch:         uint64(finalityCheckpoints.Data.CurrentJustified.Epoch),
 		PreviousJustifiedEpoch: uint64(finalityCheckpoints.Data.PreviousJustified.Epoch),
+		FinalizedRoot:          common.BytesToHash(finalityCheckpoints.Data.Finalized.Root),
+		JustifiedRoot:          common.BytesToHash(finalityCheckpoints.Data.CurrentJustified.Root),
+		PreviousJustifiedRoot:  common.BytesToHash(finalityCheckpoints.Data.PreviousJustified.Root),
 	}, nil
 
 }
 
-// Get a validator's status
-func (c *StandardHttpClient) GetValidatorStatus(pubkey types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
+// Get the node's latest finalized checkpoint, and whether it has advanced since the previous call.
+// The first call always reports it as advanced, since there's nothing to compare it to yet.
+func (c *StandardHttpClient) CheckFinalizedCheckpoint(ctx context.Context) (beacon.FinalizedCheckpointUpdate, error) {
+	ctx, cancel := c.methodContext(ctx, "CheckFinalizedCheckpoint")
+	defer cancel()
+	finalityCheckpoints, err := c.getFinalityCheckpoints(ctx, "head")
+	if err != nil {
+		return beacon.FinalizedCheckpointUpdate{}, err
+	}
+	finalized := finalityCheckpoints.Data.Finalized.toBeacon()
+
+	c.lastFinalizedLock.Lock()
+	defer c.lastFinalizedLock.Unlock()
+	if c.lastFinalized != nil && finalized.Epoch <= c.lastFinalized.Epoch {
+		return beacon.FinalizedCheckpointUpdate{
+			Checkpoint: *c.lastFinalized,
+			Advanced:   false,
+		}, nil
+	}
+	c.lastFinalized = &finalized
+	return beacon.FinalizedCheckpointUpdate{
+		Checkpoint: finalized,
+		Advanced:   true,
+	}, nil
+}
 
-	return c.getValidatorStatus(hexutil.AddPrefix(pubkey.Hex()), opts)
+// Get a validator's status
+func (c *StandardHttpClient) GetValidatorStatus(ctx context.Context, pubkey types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorStatus")
+	defer cancel()
+	return c.getValidatorStatus(ctx, hexutil.AddPrefix(pubkey.Hex()), opts)
 
 }
-func (c *StandardHttpClient) GetValidatorStatusByIndex(index string, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
-
-	return c.getValidatorStatus(index, opts)
+func (c *StandardHttpClient) GetValidatorStatusByIndex(ctx context.Context, index string, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorStatusByIndex")
+	defer cancel()
+	return c.getValidatorStatus(ctx, index, opts)
 
 }
 
-func (c *StandardHttpClient) getValidatorStatus(pubkeyOrIndex string, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
+func (c *StandardHttpClient) getValidatorStatus(ctx context.Context, pubkeyOrIndex string, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
 
 	// Return zero status for null pubkeyOrIndex
 	if pubkeyOrIndex == "" {
@@ -203,35 +585,80 @@ func (c *StandardHttpClient) getValidatorStatus(pubkeyOrIndex string, opts *beac
 	}
 
 	// Get validator
-	validators, err := c.getValidatorsByOpts([]string{pubkeyOrIndex}, opts)
+	validators, err := c.getValidatorsByOpts(ctx, []string{pubkeyOrIndex}, opts)
 	if err != nil {
 		return beacon.ValidatorStatus{}, err
 	}
+	defer validators.Release()
 	if len(validators.Data) == 0 {
 		return beacon.ValidatorStatus{}, nil
 	}
 	validator := validators.Data[0]
 
 	// Return response
-	return beacon.ValidatorStatus{
-		Pubkey:                     types.BytesToValidatorPubkey(validator.Validator.Pubkey),
-		Index:                      validator.Index,
-		WithdrawalCredentials:      common.BytesToHash(validator.Validator.WithdrawalCredentials),
-		Balance:                    uint64(validator.Balance),
-		EffectiveBalance:           uint64(validator.Validator.EffectiveBalance),
-		Status:                     beacon.ValidatorState(validator.Status),
-		Slashed:                    validator.Validator.Slashed,
-		ActivationEligibilityEpoch: uint64(validator.Validator.ActivationEligibilityEpoch),
-		ActivationEpoch:            uint64(validator.Validator.ActivationEpoch),
-		ExitEpoch:                  uint64(validator.Validator.ExitEpoch),
-		WithdrawableEpoch:          uint64(validator.Validator.WithdrawableEpoch),
-		Exists:                     true,
-	}, nil
+	return validator.toBeacon(), nil
+
+}
+
+// Get a single validator by pubkey or index; returns ErrValidatorNotFound if it doesn't exist in the state,
+// or ErrStateUnavailable if the node doesn't have the state (e.g. a historical state pruned by a non-archive node)
+func (c *StandardHttpClient) GetValidator(ctx context.Context, stateId string, validatorId string) (beacon.ValidatorStatus, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidator")
+	defer cancel()
+	response, err := c.getValidator(ctx, stateId, validatorId)
+	if err != nil {
+		return beacon.ValidatorStatus{}, err
+	}
+	return response.Data.toBeacon(), nil
+}
+
+// Get all of the validators in a state with one of the provided statuses; if no statuses are provided, all validators are returned.
+// The state can be historical, but nodes that aren't archive nodes prune old states; requests for those fail with an error wrapping beacon.ErrStateUnavailable.
+func (c *StandardHttpClient) GetValidatorsByStatus(ctx context.Context, stateId string, statuses []beacon.ValidatorState) ([]beacon.ValidatorStatus, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorsByStatus")
+	defer cancel()
+
+	statusFilter := make([]ValidatorStatus, len(statuses))
+	for i, status := range statuses {
+		statusFilter[i] = ValidatorStatus(status)
+	}
+	validators, err := c.getValidators(ctx, stateId, nil, statusFilter)
+	if err != nil {
+		return nil, err
+	}
+	defer validators.Release()
+
+	results := make([]beacon.ValidatorStatus, len(validators.Data))
+	for i := range validators.Data {
+		results[i] = validators.Data[i].toBeacon()
+	}
+	return results, nil
+}
+
+// Get every validator in a state, fetching them in chunks of chunkSize indices instead of all at once.
+// If provided, progress is called after each chunk with the number of validators fetched so far and the total.
+// The state is resolved to its root first, so every chunk comes from the same state even if stateId is one that moves, like "head".
+// Finding the total takes a few small requests before the first chunk is fetched.
+func (c *StandardHttpClient) GetValidatorsChunked(ctx context.Context, stateId string, chunkSize int, progress func(done int, total int)) ([]beacon.ValidatorStatus, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorsChunked")
+	defer cancel()
+	validators, err := c.getValidatorsChunked(ctx, stateId, chunkSize, progress)
+	if err != nil {
+		return nil, err
+	}
+	defer validators.Release()
 
+	results := make([]beacon.ValidatorStatus, len(validators.Data))
+	for i := range validators.Data {
+		results[i] = validators.Data[i].toBeacon()
+	}
+	return results, nil
 }
 
 // Get multiple validators' statuses
-func (c *StandardHttpClient) GetValidatorStatuses(pubkeys []types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (map[types.ValidatorPubkey]beacon.ValidatorStatus, error) {
+func (c *StandardHttpClient) GetValidatorStatuses(ctx context.Context, pubkeys []types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (map[types.ValidatorPubkey]beacon.ValidatorStatus, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorStatuses")
+	defer cancel()
 
 	// The null validator pubkey
 	nullPubkey := types.ValidatorPubkey{}
@@ -256,10 +683,11 @@ func (c *StandardHttpClient) GetValidatorStatuses(pubkeys []types.ValidatorPubke
 	}
 
 	// Get validators
-	validators, err := c.getValidatorsByOpts(pubkeysHex, opts)
+	validators, err := c.getValidatorsByOpts(ctx, pubkeysHex, opts)
 	if err != nil {
 		return nil, err
 	}
+	defer validators.Release()
 
 	// Build validator status map
 	statuses := make(map[types.ValidatorPubkey]beacon.ValidatorStatus)
@@ -274,20 +702,7 @@ func (c *StandardHttpClient) GetValidatorStatuses(pubkeys []types.ValidatorPubke
 		pubkey := types.BytesToValidatorPubkey(validator.Validator.Pubkey)
 
 		// Add status
-		statuses[pubkey] = beacon.ValidatorStatus{
-			Pubkey:                     types.BytesToValidatorPubkey(validator.Validator.Pubkey),
-			Index:                      validator.Index,
-			WithdrawalCredentials:      common.BytesToHash(validator.Validator.WithdrawalCredentials),
-			Balance:                    uint64(validator.Balance),
-			EffectiveBalance:           uint64(validator.Validator.EffectiveBalance),
-			Status:                     beacon.ValidatorState(validator.Status),
-			Slashed:                    validator.Validator.Slashed,
-			ActivationEligibilityEpoch: uint64(validator.Validator.ActivationEligibilityEpoch),
-			ActivationEpoch:            uint64(validator.Validator.ActivationEpoch),
-			ExitEpoch:                  uint64(validator.Validator.ExitEpoch),
-			WithdrawableEpoch:          uint64(validator.Validator.WithdrawableEpoch),
-			Exists:                     true,
-		}
+		statuses[pubkey] = validator.toBeacon()
 
 	}
 
@@ -300,10 +715,12 @@ func (c *StandardHttpClient) GetValidatorStatuses(pubkeys []types.ValidatorPubke
 }
 
 // Get whether validators have sync duties to perform at given epoch
-func (c *StandardHttpClient) GetValidatorSyncDuties(indices []string, epoch uint64) (map[string]bool, error) {
+func (c *StandardHttpClient) GetValidatorSyncDuties(ctx context.Context, indices []string, epoch uint64) (map[string]bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorSyncDuties")
+	defer cancel()
 
 	// Perform the post request
-	responseBody, status, err := c.postRequest(fmt.Sprintf(RequestValidatorSyncDuties, strconv.FormatUint(epoch, 10)), indices)
+	responseBody, status, err := c.postRequest(ctx, fmt.Sprintf(RequestValidatorSyncDuties, strconv.FormatUint(epoch, 10)), indices)
 
 	if err != nil {
 		return nil, fmt.Errorf("Could not get validator sync duties: %w", err)
@@ -313,7 +730,7 @@ func (c *StandardHttpClient) GetValidatorSyncDuties(indices []string, epoch uint
 	}
 
 	var response SyncDutiesResponse
-	if err := json.Unmarshal(responseBody, &response); err != nil {
+	if err := decodeResponse(responseBody, &response); err != nil {
 		return nil, fmt.Errorf("Could not decode validator sync duties data: %w", err)
 	}
 
@@ -334,21 +751,14 @@ func (c *StandardHttpClient) GetValidatorSyncDuties(indices []string, epoch uint
 }
 
 // Sums proposer duties per validators for a given epoch
-func (c *StandardHttpClient) GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error) {
-
-	// Perform the post request
-	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestValidatorProposerDuties, strconv.FormatUint(epoch, 10)))
+func (c *StandardHttpClient) GetValidatorProposerDuties(ctx context.Context, indices []string, epoch uint64) (map[string]uint64, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorProposerDuties")
+	defer cancel()
 
+	// Get the proposer duties
+	response, err := c.getProposerDuties(ctx, epoch)
 	if err != nil {
-		return nil, fmt.Errorf("Could not get validator proposer duties: %w", err)
-	}
-	if status != http.StatusOK {
-		return nil, fmt.Errorf("Could not get validator proposer duties: HTTP status %d; response body: '%s'", status, string(responseBody))
-	}
-
-	var response ProposerDutiesResponse
-	if err := json.Unmarshal(responseBody, &response); err != nil {
-		return nil, fmt.Errorf("Could not decode validator proposer duties data: %w", err)
+		return nil, err
 	}
 
 	// Map the results
@@ -367,15 +777,105 @@ func (c *StandardHttpClient) GetValidatorProposerDuties(indices []string, epoch
 	return proposerMap, nil
 }
 
+// Get all of the proposer duties for an epoch
+func (c *StandardHttpClient) GetProposerDuties(ctx context.Context, epoch uint64) (beacon.ProposerDuties, error) {
+	ctx, cancel := c.methodContext(ctx, "GetProposerDuties")
+	defer cancel()
+	response, err := c.getProposerDuties(ctx, epoch)
+	if err != nil {
+		return beacon.ProposerDuties{}, err
+	}
+
+	duties := make([]beacon.ProposerDuty, len(response.Data))
+	for i, duty := range response.Data {
+		duties[i] = beacon.ProposerDuty{
+			Pubkey:         types.BytesToValidatorPubkey(duty.Pubkey),
+			ValidatorIndex: duty.ValidatorIndex,
+			Slot:           uint64(duty.Slot),
+		}
+	}
+	return beacon.ProposerDuties{
+		DependentRoot:       common.BytesToHash(response.DependentRoot),
+		ExecutionOptimistic: response.ExecutionOptimistic,
+		Duties:              duties,
+	}, nil
+}
+
+// Get the attester duties for the given validators during an epoch
+func (c *StandardHttpClient) GetAttesterDuties(ctx context.Context, epoch uint64, indices []string) (beacon.AttesterDuties, error) {
+	ctx, cancel := c.methodContext(ctx, "GetAttesterDuties")
+	defer cancel()
+	response, err := c.getAttesterDuties(ctx, epoch, indices)
+	if err != nil {
+		return beacon.AttesterDuties{}, err
+	}
+
+	duties := make([]beacon.AttesterDuty, len(response.Data))
+	for i, duty := range response.Data {
+		duties[i] = beacon.AttesterDuty{
+			Pubkey:                  types.BytesToValidatorPubkey(duty.Pubkey),
+			ValidatorIndex:          duty.ValidatorIndex,
+			CommitteeIndex:          uint64(duty.CommitteeIndex),
+			CommitteeLength:         uint64(duty.CommitteeLength),
+			CommitteesAtSlot:        uint64(duty.CommitteesAtSlot),
+			ValidatorCommitteeIndex: uint64(duty.ValidatorCommitteeIndex),
+			Slot:                    uint64(duty.Slot),
+		}
+	}
+	return beacon.AttesterDuties{
+		DependentRoot:       common.BytesToHash(response.DependentRoot),
+		ExecutionOptimistic: response.ExecutionOptimistic,
+		Duties:              duties,
+	}, nil
+}
+
+// Phrases in error messages that nodes use when they don't track liveness for the requested epoch
+var livenessEpochUnavailablePhrases = []string{"more than one epoch", "out of range", "not in the range", "outside", "too far", "too old", "in the future", "in the past", "beyond", "not available", "unavailable"}
+
+// Get whether the network has seen the given validators performing their duties during an epoch
+func (c *StandardHttpClient) GetValidatorLiveness(ctx context.Context, epoch uint64, indices []string) (map[string]bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorLiveness")
+	defer cancel()
+
+	// Perform the post request
+	responseBody, status, err := c.postRequest(ctx, fmt.Sprintf(RequestValidatorLivenessPath, strconv.FormatUint(epoch, 10)), indices)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get validator liveness: %w", err)
+	}
+	if status == http.StatusBadRequest && errorMessageReports(responseBody, "epoch", livenessEpochUnavailablePhrases) {
+		// Nodes only keep liveness data for recent epochs and reject requests for anything else; other 400s are bad input, so they aren't mapped
+		return nil, fmt.Errorf("Could not get validator liveness for epoch %d: %w; response body: '%s'", epoch, beacon.ErrLivenessEpochUnavailable, string(responseBody))
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get validator liveness: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+
+	var response ValidatorLivenessResponse
+	if err := decodeResponse(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode validator liveness data: %w", err)
+	}
+
+	// Map the results
+	livenessMap := make(map[string]bool, len(response.Data))
+	for _, liveness := range response.Data {
+		livenessMap[liveness.Index] = liveness.IsLive
+	}
+
+	return livenessMap, nil
+}
+
 // Get a validator's index
-func (c *StandardHttpClient) GetValidatorIndex(pubkey types.ValidatorPubkey) (string, error) {
+func (c *StandardHttpClient) GetValidatorIndex(ctx context.Context, pubkey types.ValidatorPubkey) (string, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorIndex")
+	defer cancel()
 
 	// Get validator
 	pubkeyString := hexutil.AddPrefix(pubkey.Hex())
-	validators, err := c.getValidatorsByOpts([]string{pubkeyString}, nil)
+	validators, err := c.getValidatorsByOpts(ctx, []string{pubkeyString}, nil)
 	if err != nil {
 		return "", err
 	}
+	defer validators.Release()
 	if len(validators.Data) == 0 {
 		return "", fmt.Errorf("Validator %s index not found.", pubkeyString)
 	}
@@ -386,8 +886,57 @@ func (c *StandardHttpClient) GetValidatorIndex(pubkey types.ValidatorPubkey) (st
 
 }
 
+// Get the indices of the provided validators, keyed by their 0x-prefixed pubkey.
+// Pubkeys that don't belong to a validator on the Beacon Chain yet are left out of the map.
+func (c *StandardHttpClient) GetValidatorIndices(ctx context.Context, pubkeys []types.ValidatorPubkey) (map[string]string, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorIndices")
+	defer cancel()
+
+	// Use cached indices where possible
+	indices := make(map[string]string, len(pubkeys))
+	missing := []string{}
+	requested := map[string]bool{}
+	c.validatorIndicesLock.Lock()
+	for _, pubkey := range pubkeys {
+		pubkeyString := hexutil.AddPrefix(pubkey.Hex())
+		if index, exists := c.validatorIndices[pubkeyString]; exists {
+			indices[pubkeyString] = index
+		} else if !requested[pubkeyString] {
+			requested[pubkeyString] = true
+			missing = append(missing, pubkeyString)
+		}
+	}
+	c.validatorIndicesLock.Unlock()
+	if len(missing) == 0 {
+		return indices, nil
+	}
+
+	// Get the remaining validators
+	validators, err := c.getValidatorsByOpts(ctx, missing, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer validators.Release()
+
+	// Add them to the cache
+	c.validatorIndicesLock.Lock()
+	defer c.validatorIndicesLock.Unlock()
+	if c.validatorIndices == nil {
+		c.validatorIndices = map[string]string{}
+	}
+	for _, validator := range validators.Data {
+		pubkeyString := hexutil.AddPrefix(hex.EncodeToString(validator.Validator.Pubkey))
+		c.validatorIndices[pubkeyString] = validator.Index
+		indices[pubkeyString] = validator.Index
+	}
+	return indices, nil
+
+}
+
 // Get domain data for a domain type at a given epoch
-func (c *StandardHttpClient) GetDomainData(domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error) {
+func (c *StandardHttpClient) GetDomainData(ctx context.Context, domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error) {
+	ctx, cancel := c.methodContext(ctx, "GetDomainData")
+	defer cancel()
 
 	// Data
 	var wg errgroup.Group
@@ -397,14 +946,14 @@ func (c *StandardHttpClient) GetDomainData(domainType []byte, epoch uint64, useG
 	// Get genesis
 	wg.Go(func() error {
 		var err error
-		genesis, err = c.getGenesis()
+		genesis, err = c.getGenesis(ctx)
 		return err
 	})
 
 	// Get fork
 	wg.Go(func() error {
 		var err error
-		fork, err = c.getFork("head")
+		fork, err = c.getFork(ctx, "head")
 		return err
 	})
 
@@ -426,13 +975,16 @@ func (c *StandardHttpClient) GetDomainData(domainType []byte, epoch uint64, useG
 	// Compute & return domain
 	var dt [4]byte
 	copy(dt[:], domainType[:])
-	return eth2types.Domain(dt, forkVersion, genesis.Data.GenesisValidatorsRoot), nil
+	domain := ComputeDomain(dt, forkVersion, genesis.Data.GenesisValidatorsRoot)
+	return domain[:], nil
 
 }
 
 // Perform a voluntary exit on a validator
-func (c *StandardHttpClient) ExitValidator(validatorIndex string, epoch uint64, signature types.ValidatorSignature) error {
-	return c.postVoluntaryExit(VoluntaryExitRequest{
+func (c *StandardHttpClient) ExitValidator(ctx context.Context, validatorIndex string, epoch uint64, signature types.ValidatorSignature) error {
+	ctx, cancel := c.methodContext(ctx, "ExitValidator")
+	defer cancel()
+	return c.postVoluntaryExit(ctx, VoluntaryExitRequest{
 		Message: VoluntaryExitMessage{
 			Epoch:          uinteger(epoch),
 			ValidatorIndex: validatorIndex,
@@ -442,16 +994,18 @@ func (c *StandardHttpClient) ExitValidator(validatorIndex string, epoch uint64,
 }
 
 // Get the ETH1 data for the target beacon block
-func (c *StandardHttpClient) GetEth1DataForEth2Block(blockId string) (beacon.Eth1Data, bool, error) {
+func (c *StandardHttpClient) GetEth1DataForEth2Block(ctx context.Context, blockId string) (beacon.Eth1Data, bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetEth1DataForEth2Block")
+	defer cancel()
 
 	// Get the Beacon block
-	block, exists, err := c.getBeaconBlock(blockId)
+	block, err := c.getBeaconBlockCached(ctx, blockId)
+	if errors.Is(err, beacon.ErrSlotMissing) {
+		return beacon.Eth1Data{}, false, nil
+	}
 	if err != nil {
 		return beacon.Eth1Data{}, false, err
 	}
-	if !exists {
-		return beacon.Eth1Data{}, false, nil
-	}
 
 	// Convert the response to the eth1 data struct
 	return beacon.Eth1Data{
@@ -462,8 +1016,10 @@ func (c *StandardHttpClient) GetEth1DataForEth2Block(blockId string) (beacon.Eth
 
 }
 
-func (c *StandardHttpClient) GetAttestations(blockId string) ([]beacon.AttestationInfo, bool, error) {
-	attestations, exists, err := c.getAttestations(blockId)
+func (c *StandardHttpClient) GetAttestations(ctx context.Context, blockId string) ([]beacon.AttestationInfo, bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetAttestations")
+	defer cancel()
+	attestations, exists, err := c.getAttestations(ctx, blockId)
 	if err != nil {
 		return nil, false, err
 	}
@@ -474,51 +1030,96 @@ func (c *StandardHttpClient) GetAttestations(blockId string) ([]beacon.Attestati
 	// Add attestation info
 	attestationInfo := make([]beacon.AttestationInfo, len(attestations.Data))
 	for i, attestation := range attestations.Data {
-		bitString := hexutil.RemovePrefix(attestation.AggregationBits)
-		attestationInfo[i].SlotIndex = uint64(attestation.Data.Slot)
-		attestationInfo[i].CommitteeIndex = uint64(attestation.Data.Index)
-		attestationInfo[i].AggregationBits, err = hex.DecodeString(bitString)
+		attestationInfo[i], err = attestation.toBeaconInfo()
 		if err != nil {
-			return nil, false, fmt.Errorf("Error decoding aggregation bits for attestation %d of block %s: %w", i, blockId, err)
+			return nil, false, fmt.Errorf("Error decoding attestation %d of block %s: %w", i, blockId, err)
 		}
 	}
 
 	return attestationInfo, true, nil
 }
 
-func (c *StandardHttpClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock, bool, error) {
-	block, exists, err := c.getBeaconBlock(blockId)
+// Get the attestation data the node would have a validator in the provided committee sign for the provided slot.
+// Slots that haven't happened yet return an error wrapping beacon.ErrSlotInFuture.
+func (c *StandardHttpClient) GetAttestationData(ctx context.Context, slot uint64, committeeIndex uint64) (beacon.AttestationData, error) {
+	ctx, cancel := c.methodContext(ctx, "GetAttestationData")
+	defer cancel()
+	response, err := c.getAttestationData(ctx, slot, committeeIndex)
 	if err != nil {
-		return beacon.BeaconBlock{}, false, err
+		return beacon.AttestationData{}, err
+	}
+	return response.Data.toBeacon(), nil
+}
+
+// Get the aggregate of the attestations the node has seen for the provided attestation data root and slot.
+// Returns false if the node doesn't have any matching attestations to aggregate.
+func (c *StandardHttpClient) GetAggregateAttestation(ctx context.Context, attestationDataRoot common.Hash, slot uint64) (beacon.Attestation, bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetAggregateAttestation")
+	defer cancel()
+	response, exists, err := c.getAggregateAttestation(ctx, attestationDataRoot, slot)
+	if err != nil {
+		return beacon.Attestation{}, false, err
 	}
 	if !exists {
+		return beacon.Attestation{}, false, nil
+	}
+
+	attestation, err := response.Data.toBeacon()
+	if err != nil {
+		return beacon.Attestation{}, false, fmt.Errorf("Error decoding aggregate attestation for slot %d: %w", slot, err)
+	}
+	return attestation, true, nil
+}
+
+func (c *StandardHttpClient) GetBeaconBlock(ctx context.Context, blockId string) (beacon.BeaconBlock, bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetBeaconBlock")
+	defer cancel()
+	block, err := c.getBeaconBlockCached(ctx, blockId)
+	if errors.Is(err, beacon.ErrSlotMissing) {
 		return beacon.BeaconBlock{}, false, nil
 	}
+	if err != nil {
+		return beacon.BeaconBlock{}, false, err
+	}
 
 	beaconBlock := beacon.BeaconBlock{
 		Slot:          uint64(block.Data.Message.Slot),
 		ProposerIndex: block.Data.Message.ProposerIndex,
+		Graffiti:      block.GraffitiString(),
 	}
 
 	// Execution payload only exists after the merge, so check for its existence
 	if block.Data.Message.Body.ExecutionPayload == nil {
 		beaconBlock.HasExecutionPayload = false
 	} else {
+		payload := block.Data.Message.Body.ExecutionPayload
 		beaconBlock.HasExecutionPayload = true
-		beaconBlock.FeeRecipient = common.BytesToAddress(block.Data.Message.Body.ExecutionPayload.FeeRecipient)
-		beaconBlock.ExecutionBlockNumber = uint64(block.Data.Message.Body.ExecutionPayload.BlockNumber)
+		beaconBlock.FeeRecipient = common.BytesToAddress(payload.FeeRecipient)
+		beaconBlock.ExecutionBlockNumber = uint64(payload.BlockNumber)
+		beaconBlock.ExecutionBlockHash = common.BytesToHash(payload.BlockHash)
+		beaconBlock.GasLimit = uint64(payload.GasLimit)
+		beaconBlock.GasUsed = uint64(payload.GasUsed)
+		beaconBlock.BaseFeePerGas = payload.BaseFeePerGas.Int
+		beaconBlock.Timestamp = uint64(payload.Timestamp)
+		beaconBlock.TransactionCount = uint64(payload.Transactions)
+		beaconBlock.BlobGasUsed = uint64(payload.BlobGasUsed)
+		beaconBlock.ExcessBlobGas = uint64(payload.ExcessBlobGas)
+		beaconBlock.Withdrawals = make([]beacon.Withdrawal, len(payload.Withdrawals))
+		for i, withdrawal := range payload.Withdrawals {
+			beaconBlock.Withdrawals[i] = beacon.Withdrawal{
+				Index:          uint64(withdrawal.Index),
+				ValidatorIndex: withdrawal.ValidatorIndex,
+				Address:        withdrawal.Address,
+				Amount:         uint64(withdrawal.Amount),
+			}
+		}
 	}
 
 	// Add attestation info
 	for i, attestation := range block.Data.Message.Body.Attestations {
-		bitString := hexutil.RemovePrefix(attestation.AggregationBits)
-		info := beacon.AttestationInfo{
-			SlotIndex:      uint64(attestation.Data.Slot),
-			CommitteeIndex: uint64(attestation.Data.Index),
-		}
-		info.AggregationBits, err = hex.DecodeString(bitString)
+		info, err := attestation.toBeaconInfo()
 		if err != nil {
-			return beacon.BeaconBlock{}, false, fmt.Errorf("Error decoding aggregation bits for attestation %d of block %s: %w", i, blockId, err)
+			return beacon.BeaconBlock{}, false, fmt.Errorf("Error decoding attestation %d of block %s: %w", i, blockId, err)
 		}
 		beaconBlock.Attestations = append(beaconBlock.Attestations, info)
 	}
@@ -527,78 +1128,526 @@ func (c *StandardHttpClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock,
 }
 
 // Get the attestation committees for the given epoch, or the current epoch if nil
-func (c *StandardHttpClient) GetCommitteesForEpoch(epoch *uint64) (beacon.Committees, error) {
-	response, err := c.getCommittees("head", epoch)
+func (c *StandardHttpClient) GetCommitteesForEpoch(ctx context.Context, epoch *uint64) (beacon.Committees, error) {
+	ctx, cancel := c.methodContext(ctx, "GetCommitteesForEpoch")
+	defer cancel()
+	return c.GetCommittees(ctx, "head", epoch, nil, nil)
+}
+
+// Get the committees for a state, optionally filtered by epoch, committee index, and slot
+func (c *StandardHttpClient) GetCommittees(ctx context.Context, stateId string, epoch *uint64, index *uint64, slot *uint64) (beacon.Committees, error) {
+	ctx, cancel := c.methodContext(ctx, "GetCommittees")
+	defer cancel()
+	response, err := c.getCommittees(ctx, stateId, epoch, index, slot)
 	if err != nil {
-		return nil, err
+		// Older Prysm versions don't serve the standard committees route reliably, so fall back to its own route
+		if !c.isPrysm(ctx) {
+			return nil, err
+		}
+		var fallbackErr error
+		response, fallbackErr = c.getPrysmCommittees(ctx, stateId, epoch, index, slot)
+		if fallbackErr != nil {
+			return nil, fmt.Errorf("%w; Prysm fallback also failed: %s", err, fallbackErr.Error())
+		}
 	}
 
 	return &response, nil
 }
 
+// Get the sync committee for a state, optionally for a specific epoch.
+// Nodes only know the current and next sync committee periods; requests beyond that return ErrSyncCommitteePeriodUnavailable.
+func (c *StandardHttpClient) GetSyncCommittees(ctx context.Context, stateId string, epoch *uint64) (beacon.SyncCommittee, error) {
+	ctx, cancel := c.methodContext(ctx, "GetSyncCommittees")
+	defer cancel()
+	response, err := c.getSyncCommittees(ctx, stateId, epoch)
+	if err != nil {
+		return beacon.SyncCommittee{}, err
+	}
+
+	return beacon.SyncCommittee{
+		Validators:          response.Data.Validators,
+		ValidatorAggregates: response.Data.ValidatorAggregates,
+	}, nil
+}
+
 // Perform a withdrawal credentials change on a validator
-func (c *StandardHttpClient) ChangeWithdrawalCredentials(validatorIndex string, fromBlsPubkey types.ValidatorPubkey, toExecutionAddress common.Address, signature types.ValidatorSignature) error {
-	return c.postWithdrawalCredentialsChange(BLSToExecutionChangeRequest{
-		Message: BLSToExecutionChangeMessage{
-			ValidatorIndex:     validatorIndex,
-			FromBLSPubkey:      fromBlsPubkey[:],
-			ToExecutionAddress: toExecutionAddress[:],
-		},
-		Signature: signature.Bytes(),
-	})
+func (c *StandardHttpClient) ChangeWithdrawalCredentials(ctx context.Context, validatorIndex string, fromBlsPubkey types.ValidatorPubkey, toExecutionAddress common.Address, signature types.ValidatorSignature) error {
+	ctx, cancel := c.methodContext(ctx, "ChangeWithdrawalCredentials")
+	defer cancel()
+	return c.postWithdrawalCredentialsChange(ctx, blsToExecutionChangeFromBeacon(beacon.SignedBLSToExecutionChange{
+		ValidatorIndex:     validatorIndex,
+		FromBLSPubkey:      fromBlsPubkey,
+		ToExecutionAddress: toExecutionAddress,
+		Signature:          signature,
+	}))
 }
 
-// Get sync status
-func (c *StandardHttpClient) getSyncStatus() (SyncStatusResponse, error) {
-	responseBody, status, err := c.getRequest(RequestSyncStatusPath)
+// Get the blob sidecars for the target beacon block, optionally filtered to the provided blob indices
+func (c *StandardHttpClient) GetBlobSidecars(ctx context.Context, blockId string, indices []uint64) ([]beacon.BlobSidecar, bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetBlobSidecars")
+	defer cancel()
+	sidecars, exists, err := c.getBlobSidecars(ctx, blockId, indices)
 	if err != nil {
-		return SyncStatusResponse{}, fmt.Errorf("Could not get node sync status: %w", err)
+		return nil, false, err
 	}
-	if status != http.StatusOK {
-		return SyncStatusResponse{}, fmt.Errorf("Could not get node sync status: HTTP status %d; response body: '%s'", status, string(responseBody))
+	if !exists {
+		return nil, false, nil
 	}
-	var syncStatus SyncStatusResponse
-	if err := json.Unmarshal(responseBody, &syncStatus); err != nil {
-		return SyncStatusResponse{}, fmt.Errorf("Could not decode node sync status: %w", err)
+
+	// Blocks without blobs return an empty list, which maps to an empty slice here
+	blobSidecars := make([]beacon.BlobSidecar, len(sidecars.Data))
+	for i, sidecar := range sidecars.Data {
+		inclusionProof := make([][]byte, len(sidecar.KzgCommitmentInclusionProof))
+		for j, proof := range sidecar.KzgCommitmentInclusionProof {
+			inclusionProof[j] = proof
+		}
+		blobSidecars[i] = beacon.BlobSidecar{
+			Index:                       uint64(sidecar.Index),
+			Slot:                        uint64(sidecar.SignedBlockHeader.Message.Slot),
+			Blob:                        sidecar.Blob,
+			KzgCommitment:               sidecar.KzgCommitment,
+			KzgProof:                    sidecar.KzgProof,
+			KzgCommitmentInclusionProof: inclusionProof,
+		}
 	}
-	return syncStatus, nil
+
+	return blobSidecars, true, nil
 }
 
-// Get the eth2 config
-func (c *StandardHttpClient) getEth2Config() (Eth2ConfigResponse, error) {
-	responseBody, status, err := c.getRequest(RequestEth2ConfigPath)
+// Get the balances of the provided validators (by pubkey or index) without fetching the full validator objects.
+// The returned map is keyed by validator index. If no validators are provided, the balances of all validators are returned.
+func (c *StandardHttpClient) GetValidatorBalances(ctx context.Context, stateId string, pubkeysOrIndices []string) (map[string]uint64, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorBalances")
+	defer cancel()
+	balances, err := c.getValidatorBalances(ctx, stateId, pubkeysOrIndices)
 	if err != nil {
-		return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: %w", err)
-	}
-	if status != http.StatusOK {
-		return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: HTTP status %d; response body: '%s'", status, string(responseBody))
+		// Older Prysm versions don't accept the ID list as a POST body, so split it into GET requests instead
+		if len(validatorBalancesQuery(pubkeysOrIndices)) <= MaxValidatorsQueryLength || !c.isPrysm(ctx) {
+			return nil, err
+		}
+		var fallbackErr error
+		balances, fallbackErr = c.getPrysmValidatorBalances(ctx, stateId, pubkeysOrIndices)
+		if fallbackErr != nil {
+			return nil, fmt.Errorf("%w; Prysm fallback also failed: %s", err, fallbackErr.Error())
+		}
 	}
-	var eth2Config Eth2ConfigResponse
-	if err := json.Unmarshal(responseBody, &eth2Config); err != nil {
-		return Eth2ConfigResponse{}, fmt.Errorf("Could not decode eth2 config: %w", err)
+
+	balanceMap := make(map[string]uint64, len(balances.Data))
+	for _, balance := range balances.Data {
+		balanceMap[balance.Index] = uint64(balance.Balance)
 	}
-	return eth2Config, nil
+	return balanceMap, nil
 }
 
-// Get the eth2 deposit contract info
-func (c *StandardHttpClient) getEth2DepositContract() (Eth2DepositContractResponse, error) {
-	responseBody, status, err := c.getRequest(RequestEth2DepositContractMethod)
+// Get the withdrawals that are expected to be included in the block after the provided state
+func (c *StandardHttpClient) GetExpectedWithdrawals(ctx context.Context, stateId string) ([]beacon.Withdrawal, error) {
+	ctx, cancel := c.methodContext(ctx, "GetExpectedWithdrawals")
+	defer cancel()
+	response, err := c.getExpectedWithdrawals(ctx, stateId)
 	if err != nil {
-		return Eth2DepositContractResponse{}, fmt.Errorf("Could not get eth2 deposit contract: %w", err)
-	}
-	if status != http.StatusOK {
-		return Eth2DepositContractResponse{}, fmt.Errorf("Could not get eth2 deposit contract: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return nil, err
 	}
-	var eth2DepositContract Eth2DepositContractResponse
-	if err := json.Unmarshal(responseBody, &eth2DepositContract); err != nil {
-		return Eth2DepositContractResponse{}, fmt.Errorf("Could not decode eth2 deposit contract: %w", err)
+
+	withdrawals := make([]beacon.Withdrawal, len(response.Data))
+	for i, withdrawal := range response.Data {
+		withdrawals[i] = beacon.Withdrawal{
+			Index:          uint64(withdrawal.Index),
+			ValidatorIndex: withdrawal.ValidatorIndex,
+			Address:        withdrawal.Address,
+			Amount:         uint64(withdrawal.Amount),
+		}
 	}
-	return eth2DepositContract, nil
+	return withdrawals, nil
 }
 
-// Get genesis information
-func (c *StandardHttpClient) getGenesis() (GenesisResponse, error) {
-	responseBody, status, err := c.getRequest(RequestGenesisPath)
+// Get the consensus layer rewards the proposer earned for the target beacon block, in gwei
+func (c *StandardHttpClient) GetBlockRewards(ctx context.Context, blockId string) (beacon.BlockRewards, bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetBlockRewards")
+	defer cancel()
+	rewards, exists, err := c.getBlockRewards(ctx, blockId)
+	if err != nil {
+		return beacon.BlockRewards{}, false, err
+	}
+	if !exists {
+		return beacon.BlockRewards{}, false, nil
+	}
+
+	return beacon.BlockRewards{
+		ProposerIndex:     rewards.Data.ProposerIndex,
+		Total:             uint64(rewards.Data.Total),
+		Attestations:      uint64(rewards.Data.Attestations),
+		SyncAggregate:     uint64(rewards.Data.SyncAggregate),
+		ProposerSlashings: uint64(rewards.Data.ProposerSlashings),
+		AttesterSlashings: uint64(rewards.Data.AttesterSlashings),
+	}, true, nil
+}
+
+// Get the ideal and actual attestation rewards (in gwei) for the given validators at an epoch.
+// If no indices are provided, the rewards for all validators are returned.
+func (c *StandardHttpClient) GetAttestationRewards(ctx context.Context, epoch uint64, indices []string) (beacon.AttestationRewards, error) {
+	ctx, cancel := c.methodContext(ctx, "GetAttestationRewards")
+	defer cancel()
+	response, err := c.getAttestationRewards(ctx, epoch, indices)
+	if err != nil {
+		return beacon.AttestationRewards{}, err
+	}
+
+	rewards := beacon.AttestationRewards{
+		IdealRewards: make([]beacon.IdealAttestationRewards, len(response.Data.IdealRewards)),
+		TotalRewards: make([]beacon.ValidatorAttestationRewards, len(response.Data.TotalRewards)),
+	}
+	for i, ideal := range response.Data.IdealRewards {
+		rewards.IdealRewards[i] = beacon.IdealAttestationRewards{
+			EffectiveBalance: uint64(ideal.EffectiveBalance),
+			Head:             int64(ideal.Head),
+			Target:           int64(ideal.Target),
+			Source:           int64(ideal.Source),
+			InclusionDelay:   int64(ideal.InclusionDelay),
+			Inactivity:       int64(ideal.Inactivity),
+		}
+	}
+	for i, total := range response.Data.TotalRewards {
+		rewards.TotalRewards[i] = beacon.ValidatorAttestationRewards{
+			ValidatorIndex: total.ValidatorIndex,
+			Head:           int64(total.Head),
+			Target:         int64(total.Target),
+			Source:         int64(total.Source),
+			InclusionDelay: int64(total.InclusionDelay),
+			Inactivity:     int64(total.Inactivity),
+		}
+	}
+	return rewards, nil
+}
+
+// Get the sync committee rewards (in gwei) the given validators earned in the target beacon block, keyed by validator index.
+// Rewards are negative for validators that missed their sync committee duties. If no indices are provided, the rewards for
+// all members of the sync committee are returned.
+func (c *StandardHttpClient) GetSyncCommitteeRewards(ctx context.Context, blockId string, indices []string) (map[string]int64, bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetSyncCommitteeRewards")
+	defer cancel()
+	response, exists, err := c.getSyncCommitteeRewards(ctx, blockId, indices)
+	if err != nil {
+		return nil, false, err
+	}
+	if !exists {
+		return nil, false, nil
+	}
+
+	rewards := make(map[string]int64, len(response.Data))
+	for _, reward := range response.Data {
+		rewards[reward.ValidatorIndex] = int64(reward.Reward)
+	}
+	return rewards, true, nil
+}
+
+// Get the root of a state; stateId can be "head", "genesis", "finalized", "justified", a slot, or a state root
+func (c *StandardHttpClient) GetStateRoot(ctx context.Context, stateId string) (common.Hash, error) {
+	ctx, cancel := c.methodContext(ctx, "GetStateRoot")
+	defer cancel()
+	root, err := c.getStateRoot(ctx, stateId)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(root.Data.Root), nil
+}
+
+// Get the RANDAO mix for a state, optionally at a specific epoch
+func (c *StandardHttpClient) GetRandao(ctx context.Context, stateId string, epoch *uint64) (common.Hash, error) {
+	ctx, cancel := c.methodContext(ctx, "GetRandao")
+	defer cancel()
+	randao, err := c.getRandao(ctx, stateId, epoch)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(randao.Data.Randao), nil
+}
+
+// Get the header of a block
+func (c *StandardHttpClient) GetBlockHeader(ctx context.Context, blockId string) (beacon.BlockHeader, bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetBlockHeader")
+	defer cancel()
+	header, exists, err := c.getBlockHeader(ctx, blockId)
+	if err != nil {
+		return beacon.BlockHeader{}, false, err
+	}
+	if !exists {
+		return beacon.BlockHeader{}, false, nil
+	}
+	return header.Data.toBeacon(), true, nil
+}
+
+// Get the blocks at the provided slots, fetching up to concurrency of them at a time.
+// The result has an entry for every requested slot; slots without a block map to nil.
+func (c *StandardHttpClient) GetBeaconBlocks(ctx context.Context, slots []uint64, concurrency int) (map[uint64]*beacon.BeaconBlock, error) {
+	ctx, cancel := c.methodContext(ctx, "GetBeaconBlocks")
+	defer cancel()
+	if concurrency <= 0 {
+		concurrency = threadLimit
+	}
+
+	blocks := make([]*beacon.BeaconBlock, len(slots))
+
+	// If any block fails or the context is cancelled, abort the requests that are still in flight
+	wg, ctx := errgroup.WithContext(ctx)
+	wg.SetLimit(concurrency)
+	for i, slot := range slots {
+		i := i
+		slot := slot
+		wg.Go(func() error {
+			block, exists, err := c.GetBeaconBlock(ctx, strconv.FormatUint(slot, 10))
+			if err != nil {
+				return err
+			}
+			if exists {
+				blocks[i] = &block
+			}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, fmt.Errorf("error getting blocks: %w", err)
+	}
+
+	results := make(map[uint64]*beacon.BeaconBlock, len(slots))
+	for i, slot := range slots {
+		results[slot] = blocks[i]
+	}
+	return results, nil
+}
+
+// Get the block with the provided root, confirming that it's part of the canonical chain.
+// Returns false if the node doesn't know about the block; orphaned blocks return an error wrapping beacon.ErrBlockNotCanonical.
+func (c *StandardHttpClient) GetBeaconBlockByRoot(ctx context.Context, root common.Hash) (beacon.BeaconBlock, bool, error) {
+	ctx, cancel := c.methodContext(ctx, "GetBeaconBlockByRoot")
+	defer cancel()
+
+	// Data
+	var wg errgroup.Group
+	var block beacon.BeaconBlock
+	var header beacon.BlockHeader
+	var blockExists, headerExists bool
+
+	// Get the block
+	wg.Go(func() error {
+		var err error
+		block, blockExists, err = c.GetBeaconBlock(ctx, root.Hex())
+		return err
+	})
+
+	// Get the header, which says whether the block is canonical
+	wg.Go(func() error {
+		var err error
+		header, headerExists, err = c.GetBlockHeader(ctx, root.Hex())
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return beacon.BeaconBlock{}, false, err
+	}
+	if !blockExists || !headerExists {
+		return beacon.BeaconBlock{}, false, nil
+	}
+	if !header.Canonical {
+		return beacon.BeaconBlock{}, false, fmt.Errorf("Block %s at slot %d: %w", root.Hex(), block.Slot, beacon.ErrBlockNotCanonical)
+	}
+	return block, true, nil
+}
+
+// Get the headers of blocks matching the provided slot and/or parent root; if neither is set, the head block's header is returned
+func (c *StandardHttpClient) GetBlockHeaders(ctx context.Context, slot *uint64, parentRoot *common.Hash) ([]beacon.BlockHeader, error) {
+	ctx, cancel := c.methodContext(ctx, "GetBlockHeaders")
+	defer cancel()
+	headers, err := c.getBlockHeaders(ctx, slot, parentRoot)
+	if err != nil {
+		return nil, err
+	}
+	blockHeaders := make([]beacon.BlockHeader, len(headers.Data))
+	for i, header := range headers.Data {
+		blockHeaders[i] = header.toBeacon()
+	}
+	return blockHeaders, nil
+}
+
+// Get sync status
+func (c *StandardHttpClient) getSyncStatus(ctx context.Context) (SyncStatusResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestSyncStatusPath)
+	if err != nil {
+		return SyncStatusResponse{}, fmt.Errorf("Could not get node sync status: %w", err)
+	}
+	if status != http.StatusOK {
+		return SyncStatusResponse{}, fmt.Errorf("Could not get node sync status: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var syncStatus SyncStatusResponse
+	if err := decodeResponse(responseBody, &syncStatus); err != nil {
+		return SyncStatusResponse{}, fmt.Errorf("Could not decode node sync status: %w", err)
+	}
+	return syncStatus, nil
+}
+
+// Get the node version
+func (c *StandardHttpClient) getNodeVersion(ctx context.Context) (NodeVersionResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestNodeVersionPath)
+	if err != nil {
+		return NodeVersionResponse{}, fmt.Errorf("Could not get node version: %w", err)
+	}
+	if status != http.StatusOK {
+		return NodeVersionResponse{}, fmt.Errorf("Could not get node version: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var version NodeVersionResponse
+	if err := decodeResponse(responseBody, &version); err != nil {
+		return NodeVersionResponse{}, fmt.Errorf("Could not decode node version: %w", err)
+	}
+	return version, nil
+}
+
+// Get the node identity
+func (c *StandardHttpClient) getNodeIdentity(ctx context.Context) (NodeIdentityResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestNodeIdentityPath)
+	if err != nil {
+		return NodeIdentityResponse{}, fmt.Errorf("Could not get node identity: %w", err)
+	}
+	if status != http.StatusOK {
+		return NodeIdentityResponse{}, fmt.Errorf("Could not get node identity: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var identity NodeIdentityResponse
+	if err := decodeResponse(responseBody, &identity); err != nil {
+		return NodeIdentityResponse{}, fmt.Errorf("Could not decode node identity: %w", err)
+	}
+	return identity, nil
+}
+
+// Get the peer count
+func (c *StandardHttpClient) getPeerCount(ctx context.Context) (PeerCountResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestPeerCountPath)
+	if err != nil {
+		return PeerCountResponse{}, fmt.Errorf("Could not get node peer count: %w", err)
+	}
+	if status != http.StatusOK {
+		return PeerCountResponse{}, fmt.Errorf("Could not get node peer count: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var peerCount PeerCountResponse
+	if err := decodeResponse(responseBody, &peerCount); err != nil {
+		return PeerCountResponse{}, fmt.Errorf("Could not decode node peer count: %w", err)
+	}
+	return peerCount, nil
+}
+
+// Get the peers
+func (c *StandardHttpClient) getPeers(ctx context.Context, states []string, directions []string) (PeersResponse, error) {
+	query := url.Values{}
+	for _, state := range states {
+		query.Add("state", state)
+	}
+	for _, direction := range directions {
+		query.Add("direction", direction)
+	}
+	requestPath := RequestPeersPath
+	if len(query) > 0 {
+		requestPath += "?" + query.Encode()
+	}
+	responseBody, status, err := c.getRequest(ctx, requestPath)
+	if err != nil {
+		return PeersResponse{}, fmt.Errorf("Could not get node peers: %w", err)
+	}
+	if status != http.StatusOK {
+		return PeersResponse{}, fmt.Errorf("Could not get node peers: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var peers PeersResponse
+	if err := decodeResponse(responseBody, &peers); err != nil {
+		return PeersResponse{}, fmt.Errorf("Could not decode node peers: %w", err)
+	}
+	return peers, nil
+}
+
+// Get the eth2 config
+func (c *StandardHttpClient) getEth2Config(ctx context.Context) (Eth2ConfigResponse, error) {
+	c.eth2ConfigLock.Lock()
+	defer c.eth2ConfigLock.Unlock()
+	if c.eth2Config != nil {
+		return *c.eth2Config, nil
+	}
+
+	responseBody, status, err := c.getRequest(ctx, RequestEth2ConfigPath)
+	if err != nil {
+		return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: %w", err)
+	}
+	if status != http.StatusOK {
+		return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var eth2Config Eth2ConfigResponse
+
+	// Nodes that don't know about a fork omit it from the spec, so default them to unscheduled
+	eth2Config.Data.DenebForkEpoch = uinteger(beacon.FarFutureEpoch)
+	eth2Config.Data.ElectraForkEpoch = uinteger(beacon.FarFutureEpoch)
+
+	if err := decodeResponse(responseBody, &eth2Config); err != nil {
+		return Eth2ConfigResponse{}, fmt.Errorf("Could not decode eth2 config: %w", err)
+	}
+	c.eth2Config = &eth2Config
+	return eth2Config, nil
+}
+
+// Get the eth2 deposit contract info
+func (c *StandardHttpClient) getEth2DepositContract(ctx context.Context) (Eth2DepositContractResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestEth2DepositContractMethod)
+	if err != nil {
+		return Eth2DepositContractResponse{}, fmt.Errorf("Could not get eth2 deposit contract: %w", err)
+	}
+	if status != http.StatusOK {
+		return Eth2DepositContractResponse{}, fmt.Errorf("Could not get eth2 deposit contract: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var eth2DepositContract Eth2DepositContractResponse
+	if err := decodeResponse(responseBody, &eth2DepositContract); err != nil {
+		return Eth2DepositContractResponse{}, fmt.Errorf("Could not decode eth2 deposit contract: %w", err)
+	}
+	return eth2DepositContract, nil
+}
+
+// Get the fork schedule
+func (c *StandardHttpClient) getForkSchedule(ctx context.Context) (ForkScheduleResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestForkSchedulePath)
+	if err != nil {
+		return ForkScheduleResponse{}, fmt.Errorf("Could not get fork schedule: %w", err)
+	}
+	if status != http.StatusOK {
+		return ForkScheduleResponse{}, fmt.Errorf("Could not get fork schedule: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var forkSchedule ForkScheduleResponse
+	if err := decodeResponse(responseBody, &forkSchedule); err != nil {
+		return ForkScheduleResponse{}, fmt.Errorf("Could not decode fork schedule: %w", err)
+	}
+	return forkSchedule, nil
+}
+
+// Get the deposit snapshot
+func (c *StandardHttpClient) getDepositSnapshot(ctx context.Context) (DepositSnapshotResponse, bool, error) {
+	responseBody, status, err := c.getRequest(ctx, RequestDepositSnapshotPath)
+	if err != nil {
+		return DepositSnapshotResponse{}, false, fmt.Errorf("Could not get deposit snapshot: %w", err)
+	}
+	if status == http.StatusNotFound {
+		return DepositSnapshotResponse{}, false, nil
+	}
+	if status != http.StatusOK {
+		return DepositSnapshotResponse{}, false, fmt.Errorf("Could not get deposit snapshot: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var snapshot DepositSnapshotResponse
+	if err := decodeResponse(responseBody, &snapshot); err != nil {
+		return DepositSnapshotResponse{}, false, fmt.Errorf("Could not decode deposit snapshot: %w", err)
+	}
+	return snapshot, true, nil
+}
+
+// Get genesis information
+func (c *StandardHttpClient) getGenesis(ctx context.Context) (GenesisResponse, error) {
+	c.genesisLock.Lock()
+	defer c.genesisLock.Unlock()
+	if c.genesis != nil {
+		return *c.genesis, nil
+	}
+
+	responseBody, status, err := c.getRequest(ctx, RequestGenesisPath)
 	if err != nil {
 		return GenesisResponse{}, fmt.Errorf("Could not get genesis data: %w", err)
 	}
@@ -606,15 +1655,16 @@ func (c *StandardHttpClient) getGenesis() (GenesisResponse, error) {
 		return GenesisResponse{}, fmt.Errorf("Could not get genesis data: HTTP status %d; response body: '%s'", status, string(responseBody))
 	}
 	var genesis GenesisResponse
-	if err := json.Unmarshal(responseBody, &genesis); err != nil {
+	if err := decodeResponse(responseBody, &genesis); err != nil {
 		return GenesisResponse{}, fmt.Errorf("Could not decode genesis: %w", err)
 	}
+	c.genesis = &genesis
 	return genesis, nil
 }
 
 // Get finality checkpoints
-func (c *StandardHttpClient) getFinalityCheckpoints(stateId string) (FinalityCheckpointsResponse, error) {
-	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestFinalityCheckpointsPath, stateId))
+func (c *StandardHttpClient) getFinalityCheckpoints(ctx context.Context, stateId string) (FinalityCheckpointsResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestFinalityCheckpointsPath, stateId))
 	if err != nil {
 		return FinalityCheckpointsResponse{}, fmt.Errorf("Could not get finality checkpoints: %w", err)
 	}
@@ -622,15 +1672,18 @@ func (c *StandardHttpClient) getFinalityCheckpoints(stateId string) (FinalityChe
 		return FinalityCheckpointsResponse{}, fmt.Errorf("Could not get finality checkpoints: HTTP status %d; response body: '%s'", status, string(responseBody))
 	}
 	var finalityCheckpoints FinalityCheckpointsResponse
-	if err := json.Unmarshal(responseBody, &finalityCheckpoints); err != nil {
+	if err := decodeResponse(responseBody, &finalityCheckpoints); err != nil {
 		return FinalityCheckpointsResponse{}, fmt.Errorf("Could not decode finality checkpoints: %w", err)
 	}
+	if stateId == "head" {
+		c.setFinalizedEpoch(uint64(finalityCheckpoints.Data.Finalized.Epoch))
+	}
 	return finalityCheckpoints, nil
 }
 
 // Get fork
-func (c *StandardHttpClient) getFork(stateId string) (ForkResponse, error) {
-	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestForkPath, stateId))
+func (c *StandardHttpClient) getFork(ctx context.Context, stateId string) (ForkResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestForkPath, stateId))
 	if err != nil {
 		return ForkResponse{}, fmt.Errorf("Could not get fork data: %w", err)
 	}
@@ -638,34 +1691,302 @@ func (c *StandardHttpClient) getFork(stateId string) (ForkResponse, error) {
 		return ForkResponse{}, fmt.Errorf("Could not get fork data: HTTP status %d; response body: '%s'", status, string(responseBody))
 	}
 	var fork ForkResponse
-	if err := json.Unmarshal(responseBody, &fork); err != nil {
+	if err := decodeResponse(responseBody, &fork); err != nil {
 		return ForkResponse{}, fmt.Errorf("Could not decode fork data: %w", err)
 	}
 	return fork, nil
 }
 
 // Get validators
-func (c *StandardHttpClient) getValidators(stateId string, pubkeys []string) (ValidatorsResponse, error) {
-	var query string
+func (c *StandardHttpClient) getValidators(ctx context.Context, stateId string, pubkeys []string, statuses []ValidatorStatus) (ValidatorsResponse, error) {
+
+	// IDs are sent as a single comma-separated list, but statuses are repeated since some nodes don't accept a list for them
+	queryParams := []string{}
 	if len(pubkeys) > 0 {
-		query = fmt.Sprintf("?id=%s", strings.Join(pubkeys, ","))
+		queryParams = append(queryParams, "id="+strings.Join(pubkeys, ","))
 	}
-	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestValidatorsPath, stateId) + query)
+	for _, status := range statuses {
+		queryParams = append(queryParams, "status="+url.QueryEscape(string(status)))
+	}
+	query := strings.Join(queryParams, "&")
+
+	// Send large queries in the request body instead of the URL
+	if len(query) > MaxValidatorsQueryLength {
+		return c.getValidatorsWithoutQuery(ctx, stateId, pubkeys, statuses)
+	}
+
+	// The full validator set can come from the Beacon state as SSZ if enabled, unless the node has already told us it doesn't support it
+	if c.useSszValidators && len(pubkeys) == 0 && !c.sszValidatorsUnsupported.Load() {
+		validators, supported, err := c.getValidatorsSsz(ctx, stateId, statuses)
+		if err != nil {
+			return ValidatorsResponse{}, err
+		}
+		if supported {
+			return validators, nil
+		}
+	}
+
+	requestPath := fmt.Sprintf(RequestValidatorsPath, stateId)
+	if len(query) > 0 {
+		requestPath += "?" + query
+	}
+
+	responseBody, status, err := c.getRequest(ctx, requestPath)
 	if err != nil {
 		return ValidatorsResponse{}, fmt.Errorf("Could not get validators: %w", err)
 	}
+	if err := stateUnavailableError(stateId, status, responseBody); err != nil {
+		return ValidatorsResponse{}, fmt.Errorf("Could not get validators: %w", err)
+	}
 	if status != http.StatusOK {
 		return ValidatorsResponse{}, fmt.Errorf("Could not get validators: HTTP status %d; response body: '%s'", status, string(responseBody))
 	}
 	var validators ValidatorsResponse
-	if err := json.Unmarshal(responseBody, &validators); err != nil {
+	if err := decodeResponse(responseBody, &validators); err != nil {
 		return ValidatorsResponse{}, fmt.Errorf("Could not decode validators: %w", err)
 	}
 	return validators, nil
 }
 
+// Get every validator in a state in chunks of indices
+func (c *StandardHttpClient) getValidatorsChunked(ctx context.Context, stateId string, chunkSize int, progress func(done int, total int)) (ValidatorsResponse, error) {
+	if chunkSize <= 0 {
+		return ValidatorsResponse{}, fmt.Errorf("Could not get validators: invalid chunk size %d", chunkSize)
+	}
+
+	// Pin the state so the count and every chunk come from the same one
+	stateRoot, err := c.getStateRoot(ctx, stateId)
+	if err != nil {
+		return ValidatorsResponse{}, err
+	}
+	stateId = hexutil.AddPrefix(hex.EncodeToString(stateRoot.Data.Root))
+
+	// Validator indices are contiguous, so the total is one past the highest index in the state
+	total, err := c.getValidatorCount(ctx, stateId)
+	if err != nil {
+		return ValidatorsResponse{}, err
+	}
+
+	var validators ValidatorsResponse
+	for start := 0; start < total; start += chunkSize {
+		end := start + chunkSize
+		if end > total {
+			end = total
+		}
+		indices := make([]string, 0, end-start)
+		for index := start; index < end; index++ {
+			indices = append(indices, strconv.Itoa(index))
+		}
+		chunk, err := c.getValidators(ctx, stateId, indices, nil)
+		if err != nil {
+			validators.Release()
+			return ValidatorsResponse{}, err
+		}
+		if validators.Data == nil {
+			validators = chunk
+		} else {
+			validators.Data = append(validators.Data, chunk.Data...)
+			chunk.Release()
+		}
+		if progress != nil {
+			progress(len(validators.Data), total)
+		}
+	}
+	return validators, nil
+}
+
+// Validator index search settings
+const (
+	validatorCountProbeWidth = 256 // The number of candidate indices checked per request
+	maxValidatorIndexBits    = 40  // Validator indices are bounded by VALIDATOR_REGISTRY_LIMIT, which is 2^40
+)
+
+// Get the number of validators in a state by searching for the highest index that exists.
+// Each request checks many candidate indices at once, so this takes a handful of requests even for the mainnet validator set.
+func (c *StandardHttpClient) getValidatorCount(ctx context.Context, stateId string) (int, error) {
+
+	// Get the highest of the candidate indices that exists in the state, or -1 if none of them do
+	highestExisting := func(candidates []int) (int, error) {
+		ids := make([]string, len(candidates))
+		for i, index := range candidates {
+			ids[i] = strconv.Itoa(index)
+		}
+		validators, err := c.getValidators(ctx, stateId, ids, nil)
+		if err != nil {
+			return 0, err
+		}
+		defer validators.Release()
+		highest := -1
+		for _, validator := range validators.Data {
+			index, err := strconv.Atoi(validator.Index)
+			if err != nil {
+				return 0, fmt.Errorf("Could not get validator count: invalid validator index '%s'", validator.Index)
+			}
+			if index > highest {
+				highest = index
+			}
+		}
+		return highest, nil
+	}
+
+	// Find the range the count is in by checking one below every power of two at once
+	candidates := make([]int, 0, maxValidatorIndexBits)
+	for bits := 0; bits < maxValidatorIndexBits; bits++ {
+		candidates = append(candidates, (1<<bits)-1)
+	}
+	highest, err := highestExisting(candidates)
+	if err != nil {
+		return 0, err
+	}
+	if highest < 0 {
+		return 0, nil
+	}
+
+	// The count is between low and high inclusive; narrow it down by checking evenly spaced indices across the range
+	low, high := highest+1, 2*highest+1
+	for low < high {
+		step := (high - low + validatorCountProbeWidth - 1) / validatorCountProbeWidth
+		candidates = candidates[:0]
+		for index := low; index < high; index += step {
+			candidates = append(candidates, index)
+		}
+		highest, err := highestExisting(candidates)
+		if err != nil {
+			return 0, err
+		}
+		if highest < low {
+			// None of the candidates exist, including low itself
+			high = low
+			continue
+		}
+		low = highest + 1
+		if highest+step < high {
+			// The next candidate didn't exist
+			high = highest + step
+		}
+	}
+	return low, nil
+}
+
+// Get validators for a query that's too long to fit in a URL.
+// This uses the POST form of the validators route; if the node doesn't support it, the query is split in half until it fits.
+func (c *StandardHttpClient) getValidatorsWithoutQuery(ctx context.Context, stateId string, pubkeys []string, statuses []ValidatorStatus) (ValidatorsResponse, error) {
+	if !c.postValidatorsUnsupported.Load() {
+		validators, supported, err := c.postValidators(ctx, stateId, pubkeys, statuses)
+		if err != nil {
+			return ValidatorsResponse{}, err
+		}
+		if supported {
+			return validators, nil
+		}
+		c.postValidatorsUnsupported.Store(true)
+	}
+
+	half := len(pubkeys) / 2
+	if half == 0 {
+		return ValidatorsResponse{}, fmt.Errorf("Could not get validators: query is too long")
+	}
+	validators, err := c.getValidators(ctx, stateId, pubkeys[:half], statuses)
+	if err != nil {
+		return ValidatorsResponse{}, err
+	}
+	rest, err := c.getValidators(ctx, stateId, pubkeys[half:], statuses)
+	if err != nil {
+		validators.Release()
+		return ValidatorsResponse{}, err
+	}
+	validators.Data = append(validators.Data, rest.Data...)
+	rest.Release()
+	return validators, nil
+}
+
+// Get validators using the POST form of the validators route; returns false if the node doesn't support it
+func (c *StandardHttpClient) postValidators(ctx context.Context, stateId string, pubkeys []string, statuses []ValidatorStatus) (ValidatorsResponse, bool, error) {
+	request := ValidatorsRequest{
+		Ids:      pubkeys,
+		Statuses: statuses,
+	}
+	responseBody, status, err := c.postRequest(ctx, fmt.Sprintf(RequestValidatorsPath, stateId), request)
+	if err != nil {
+		return ValidatorsResponse{}, false, fmt.Errorf("Could not get validators: %w", err)
+	}
+	if status == http.StatusMethodNotAllowed {
+		return ValidatorsResponse{}, false, nil
+	}
+	if err := stateUnavailableError(stateId, status, responseBody); err != nil {
+		return ValidatorsResponse{}, false, fmt.Errorf("Could not get validators: %w", err)
+	}
+	if status != http.StatusOK {
+		return ValidatorsResponse{}, false, fmt.Errorf("Could not get validators: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var validators ValidatorsResponse
+	if err := decodeResponse(responseBody, &validators); err != nil {
+		return ValidatorsResponse{}, false, fmt.Errorf("Could not decode validators: %w", err)
+	}
+	return validators, true, nil
+}
+
+// Get a single validator
+func (c *StandardHttpClient) getValidator(ctx context.Context, stateId string, validatorId string) (ValidatorResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestValidatorPath, stateId, validatorId))
+	if err != nil {
+		return ValidatorResponse{}, fmt.Errorf("Could not get validator %s: %w", validatorId, err)
+	}
+	if status != http.StatusOK && reportsMissingState(responseBody) {
+		return ValidatorResponse{}, fmt.Errorf("Could not get validator %s: %w", validatorId, stateUnavailableError(stateId, http.StatusNotFound, responseBody))
+	}
+	if status == http.StatusNotFound {
+		return ValidatorResponse{}, fmt.Errorf("Could not get validator %s: %w", validatorId, beacon.ErrValidatorNotFound)
+	}
+	if status != http.StatusOK {
+		return ValidatorResponse{}, fmt.Errorf("Could not get validator %s: HTTP status %d; response body: '%s'", validatorId, status, string(responseBody))
+	}
+	var validator ValidatorResponse
+	if err := decodeResponse(responseBody, &validator); err != nil {
+		return ValidatorResponse{}, fmt.Errorf("Could not decode validator %s: %w", validatorId, err)
+	}
+	return validator, nil
+}
+
+// Get validator balances
+func (c *StandardHttpClient) getValidatorBalances(ctx context.Context, stateId string, pubkeysOrIndices []string) (ValidatorBalancesResponse, error) {
+	var responseBody []byte
+	var status int
+	var err error
+	query := validatorBalancesQuery(pubkeysOrIndices)
+	if len(query) > MaxValidatorsQueryLength {
+		// Large ID lists don't fit in the query string, so send them in the body instead
+		responseBody, status, err = c.postRequest(ctx, fmt.Sprintf(RequestValidatorBalancesPath, stateId), pubkeysOrIndices)
+	} else {
+		requestPath := fmt.Sprintf(RequestValidatorBalancesPath, stateId)
+		if len(query) > 0 {
+			requestPath += "?" + query
+		}
+		responseBody, status, err = c.getRequest(ctx, requestPath)
+	}
+	if err != nil {
+		return ValidatorBalancesResponse{}, fmt.Errorf("Could not get validator balances: %w", err)
+	}
+	if status != http.StatusOK {
+		return ValidatorBalancesResponse{}, fmt.Errorf("Could not get validator balances: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var balances ValidatorBalancesResponse
+	if err := decodeResponse(responseBody, &balances); err != nil {
+		return ValidatorBalancesResponse{}, fmt.Errorf("Could not decode validator balances: %w", err)
+	}
+	return balances, nil
+}
+
+// Get the query string for a validator balances request for the provided IDs, without the leading "?"
+func validatorBalancesQuery(pubkeysOrIndices []string) string {
+	if len(pubkeysOrIndices) == 0 {
+		return ""
+	}
+	return "id=" + strings.Join(pubkeysOrIndices, ",")
+}
+
 // Get validators by pubkeys and status options
-func (c *StandardHttpClient) getValidatorsByOpts(pubkeysOrIndices []string, opts *beacon.ValidatorStatusOptions) (ValidatorsResponse, error) {
+func (c *StandardHttpClient) getValidatorsByOpts(ctx context.Context, pubkeysOrIndices []string, opts *beacon.ValidatorStatusOptions) (ValidatorsResponse, error) {
 
 	// Get state ID
 	var stateId string
@@ -676,7 +1997,7 @@ func (c *StandardHttpClient) getValidatorsByOpts(pubkeysOrIndices []string, opts
 	} else if opts.Epoch != nil {
 
 		// Get eth2 config
-		eth2Config, err := c.getEth2Config()
+		eth2Config, err := c.getEth2Config(ctx)
 		if err != nil {
 			return ValidatorsResponse{}, err
 		}
@@ -692,7 +2013,9 @@ func (c *StandardHttpClient) getValidatorsByOpts(pubkeysOrIndices []string, opts
 	count := len(pubkeysOrIndices)
 	data := make([]Validator, count)
 	validFlags := make([]bool, count)
-	var wg errgroup.Group
+
+	// If any batch fails or the context is cancelled, abort the batches that are still in flight
+	wg, ctx := errgroup.WithContext(ctx)
 	wg.SetLimit(threadLimit)
 	for i := 0; i < count; i += MaxRequestValidatorsCount {
 		i := i
@@ -704,7 +2027,7 @@ func (c *StandardHttpClient) getValidatorsByOpts(pubkeysOrIndices []string, opts
 		wg.Go(func() error {
 			// Get & add validators
 			batch := pubkeysOrIndices[i:max]
-			validators, err := c.getValidators(stateId, batch)
+			validators, err := c.getValidators(ctx, stateId, batch, nil)
 			if err != nil {
 				return fmt.Errorf("error getting validator statuses: %w", err)
 			}
@@ -712,6 +2035,7 @@ func (c *StandardHttpClient) getValidatorsByOpts(pubkeysOrIndices []string, opts
 				data[i+j] = responseData
 				validFlags[i+j] = true
 			}
+			validators.Release()
 			return nil
 		})
 	}
@@ -721,7 +2045,7 @@ func (c *StandardHttpClient) getValidatorsByOpts(pubkeysOrIndices []string, opts
 	}
 
 	// Clip all of the empty responses so only the valid pubkeys get returned
-	trueData := make([]Validator, 0, count)
+	trueData := validatorsSlicePool.Get().([]Validator)
 	for i, valid := range validFlags {
 		if valid {
 			trueData = append(trueData, data[i])
@@ -732,20 +2056,20 @@ func (c *StandardHttpClient) getValidatorsByOpts(pubkeysOrIndices []string, opts
 }
 
 // Send voluntary exit request
-func (c *StandardHttpClient) postVoluntaryExit(request VoluntaryExitRequest) error {
-	responseBody, status, err := c.postRequest(RequestVoluntaryExitPath, request)
+func (c *StandardHttpClient) postVoluntaryExit(ctx context.Context, request VoluntaryExitRequest) error {
+	responseBody, status, err := c.postRequest(ctx, RequestVoluntaryExitPath, request)
 	if err != nil {
-		return fmt.Errorf("Could not broadcast exit for validator at index %d: %w", request.Message.ValidatorIndex, err)
+		return fmt.Errorf("Could not broadcast exit for validator at index %s: %w", request.Message.ValidatorIndex, err)
 	}
 	if status != http.StatusOK {
-		return fmt.Errorf("Could not broadcast exit for validator at index %d: HTTP status %d; response body: '%s'", request.Message.ValidatorIndex, status, string(responseBody))
+		return fmt.Errorf("Could not broadcast exit for validator at index %s: HTTP status %d; response body: '%s'", request.Message.ValidatorIndex, status, string(responseBody))
 	}
 	return nil
 }
 
 // Get the target beacon block
-func (c *StandardHttpClient) getAttestations(blockId string) (AttestationsResponse, bool, error) {
-	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestAttestationsPath, blockId))
+func (c *StandardHttpClient) getAttestations(ctx context.Context, blockId string) (AttestationsResponse, bool, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestAttestationsPath, blockId))
 	if err != nil {
 		return AttestationsResponse{}, false, fmt.Errorf("Could not get attestations data for slot %s: %w", blockId, err)
 	}
@@ -756,29 +2080,226 @@ func (c *StandardHttpClient) getAttestations(blockId string) (AttestationsRespon
 		return AttestationsResponse{}, false, fmt.Errorf("Could not get attestations data for slot %s: HTTP status %d; response body: '%s'", blockId, status, string(responseBody))
 	}
 	var attestations AttestationsResponse
-	if err := json.Unmarshal(responseBody, &attestations); err != nil {
+	if err := decodeResponse(responseBody, &attestations); err != nil {
 		return AttestationsResponse{}, false, fmt.Errorf("Could not decode attestations data for slot %s: %w", blockId, err)
 	}
 	return attestations, true, nil
 }
 
-// Get the target beacon block
-func (c *StandardHttpClient) getBeaconBlock(blockId string) (BeaconBlockResponse, bool, error) {
-	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestBeaconBlockPath, blockId))
+// Phrases in error messages that nodes use when a requested slot hasn't happened yet
+var slotInFuturePhrases = []string{"in the future", "future slot", "ahead of", "greater than the current", "greater than current", "after the current"}
+
+// Check if a slot is past the current wall clock slot
+func (c *StandardHttpClient) isSlotInFuture(ctx context.Context, slot uint64) (bool, error) {
+	eth2Config, err := c.getEth2Config(ctx)
+	if err != nil {
+		return false, err
+	}
+	genesis, err := c.getGenesis(ctx)
+	if err != nil {
+		return false, err
+	}
+	genesisTime := uint64(genesis.Data.GenesisTime)
+	secondsPerSlot := uint64(eth2Config.Data.SecondsPerSlot)
+	if secondsPerSlot == 0 {
+		return false, nil
+	}
+	slotStart := genesisTime + slot*secondsPerSlot
+	return slotStart > uint64(time.Now().Unix()), nil
+}
+
+// Get attestation data
+func (c *StandardHttpClient) getAttestationData(ctx context.Context, slot uint64, committeeIndex uint64) (AttestationDataResponse, error) {
+	query := url.Values{}
+	query.Set("slot", strconv.FormatUint(slot, 10))
+	query.Set("committee_index", strconv.FormatUint(committeeIndex, 10))
+	responseBody, status, err := c.getRequest(ctx, RequestAttestationDataPath+"?"+query.Encode())
+	if err != nil {
+		return AttestationDataResponse{}, fmt.Errorf("Could not get attestation data for slot %d: %w", slot, err)
+	}
+	if status == http.StatusBadRequest {
+		// Nodes reject slots that are past the current wall clock slot with a 400, but also bad committee indices, so check which one this is
+		inFuture := errorMessageReports(responseBody, "slot", slotInFuturePhrases)
+		if !inFuture {
+			inFuture, err = c.isSlotInFuture(ctx, slot)
+			if err != nil {
+				return AttestationDataResponse{}, fmt.Errorf("Could not get attestation data for slot %d: %w", slot, err)
+			}
+		}
+		if inFuture {
+			return AttestationDataResponse{}, fmt.Errorf("Could not get attestation data for slot %d: %w; response body: '%s'", slot, beacon.ErrSlotInFuture, string(responseBody))
+		}
+	}
+	if status != http.StatusOK {
+		return AttestationDataResponse{}, fmt.Errorf("Could not get attestation data for slot %d: HTTP status %d; response body: '%s'", slot, status, string(responseBody))
+	}
+	var data AttestationDataResponse
+	if err := decodeResponse(responseBody, &data); err != nil {
+		return AttestationDataResponse{}, fmt.Errorf("Could not decode attestation data for slot %d: %w", slot, err)
+	}
+	return data, nil
+}
+
+// Get an aggregate attestation
+func (c *StandardHttpClient) getAggregateAttestation(ctx context.Context, attestationDataRoot common.Hash, slot uint64) (AggregateAttestationResponse, bool, error) {
+	query := url.Values{}
+	query.Set("attestation_data_root", attestationDataRoot.Hex())
+	query.Set("slot", strconv.FormatUint(slot, 10))
+	responseBody, status, err := c.getRequest(ctx, RequestAggregateAttestationPath+"?"+query.Encode())
 	if err != nil {
-		return BeaconBlockResponse{}, false, fmt.Errorf("Could not get beacon block data: %w", err)
+		return AggregateAttestationResponse{}, false, fmt.Errorf("Could not get aggregate attestation for slot %d: %w", slot, err)
 	}
 	if status == http.StatusNotFound {
-		return BeaconBlockResponse{}, false, nil
+		return AggregateAttestationResponse{}, false, nil
 	}
 	if status != http.StatusOK {
-		return BeaconBlockResponse{}, false, fmt.Errorf("Could not get beacon block data: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return AggregateAttestationResponse{}, false, fmt.Errorf("Could not get aggregate attestation for slot %d: HTTP status %d; response body: '%s'", slot, status, string(responseBody))
+	}
+	var attestation AggregateAttestationResponse
+	if err := decodeResponse(responseBody, &attestation); err != nil {
+		return AggregateAttestationResponse{}, false, fmt.Errorf("Could not decode aggregate attestation for slot %d: %w", slot, err)
+	}
+	return attestation, true, nil
+}
+
+// Get the target beacon block.
+// If the slot doesn't have a block (it was skipped or orphaned), the returned error wraps beacon.ErrSlotMissing.
+func (c *StandardHttpClient) getBeaconBlock(ctx context.Context, blockId string) (BeaconBlockResponse, error) {
+	responseBody, status, header, err := c.getRequestWithHeader(ctx, fmt.Sprintf(RequestBeaconBlockPath, blockId))
+	if err != nil {
+		return BeaconBlockResponse{}, fmt.Errorf("Could not get beacon block data: %w", err)
+	}
+	if status == http.StatusNotFound {
+		return BeaconBlockResponse{}, fmt.Errorf("Could not get beacon block data for slot %s: %w", blockId, beacon.ErrSlotMissing)
+	}
+	if status != http.StatusOK {
+		return BeaconBlockResponse{}, fmt.Errorf("Could not get beacon block data: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	if c.strictVersionChecks {
+		if err := checkBlockVersion(header.Get(ConsensusVersionHeader), responseBody); err != nil {
+			return BeaconBlockResponse{}, fmt.Errorf("Could not decode beacon block data for slot %s: %w", blockId, err)
+		}
 	}
 	var beaconBlock BeaconBlockResponse
-	if err := json.Unmarshal(responseBody, &beaconBlock); err != nil {
-		return BeaconBlockResponse{}, false, fmt.Errorf("Could not decode beacon block data: %w", err)
+	if err := decodeResponse(responseBody, &beaconBlock); err != nil {
+		return BeaconBlockResponse{}, fmt.Errorf("Could not decode beacon block data: %w", err)
 	}
-	return beaconBlock, true, nil
+	return beaconBlock, nil
+}
+
+// Get the blob sidecars for the target beacon block
+func (c *StandardHttpClient) getBlobSidecars(ctx context.Context, blockId string, indices []uint64) (BlobSidecarsResponse, bool, error) {
+	var query string
+	if len(indices) > 0 {
+		indexStrings := make([]string, len(indices))
+		for i, index := range indices {
+			indexStrings[i] = strconv.FormatUint(index, 10)
+		}
+		query = fmt.Sprintf("?indices=%s", strings.Join(indexStrings, ","))
+	}
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestBlobSidecarsPath, blockId)+query)
+	if err != nil {
+		return BlobSidecarsResponse{}, false, fmt.Errorf("Could not get blob sidecars for block %s: %w", blockId, err)
+	}
+	if status == http.StatusNotFound {
+		return BlobSidecarsResponse{}, false, nil
+	}
+	if status != http.StatusOK {
+		return BlobSidecarsResponse{}, false, fmt.Errorf("Could not get blob sidecars for block %s: HTTP status %d; response body: '%s'", blockId, status, string(responseBody))
+	}
+	var sidecars BlobSidecarsResponse
+	if err := decodeResponse(responseBody, &sidecars); err != nil {
+		return BlobSidecarsResponse{}, false, fmt.Errorf("Could not decode blob sidecars for block %s: %w", blockId, err)
+	}
+	return sidecars, true, nil
+}
+
+// Phrases that go with "future" in error messages that nodes use when a state is too far past their head
+var stateTooFarInFuturePhrases = []string{"too far", "state", "slot"}
+
+// Get the expected withdrawals for a state
+func (c *StandardHttpClient) getExpectedWithdrawals(ctx context.Context, stateId string) (ExpectedWithdrawalsResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestExpectedWithdrawalsPath, stateId))
+	if err != nil {
+		return ExpectedWithdrawalsResponse{}, fmt.Errorf("Could not get expected withdrawals for state %s: %w", stateId, err)
+	}
+	if status == http.StatusBadRequest && errorMessageReports(responseBody, "future", stateTooFarInFuturePhrases) {
+		// Nodes reject states that are too far past their head with a 400, along with invalid or pre-Capella state IDs
+		return ExpectedWithdrawalsResponse{}, fmt.Errorf("Could not get expected withdrawals for state %s: %w; response body: '%s'", stateId, beacon.ErrStateTooFarInFuture, string(responseBody))
+	}
+	if status != http.StatusOK {
+		return ExpectedWithdrawalsResponse{}, fmt.Errorf("Could not get expected withdrawals for state %s: HTTP status %d; response body: '%s'", stateId, status, string(responseBody))
+	}
+	var withdrawals ExpectedWithdrawalsResponse
+	if err := decodeResponse(responseBody, &withdrawals); err != nil {
+		return ExpectedWithdrawalsResponse{}, fmt.Errorf("Could not decode expected withdrawals for state %s: %w", stateId, err)
+	}
+	return withdrawals, nil
+}
+
+// Get the block rewards for the target beacon block
+func (c *StandardHttpClient) getBlockRewards(ctx context.Context, blockId string) (BlockRewardsResponse, bool, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestBlockRewardsPath, blockId))
+	if err != nil {
+		return BlockRewardsResponse{}, false, fmt.Errorf("Could not get block rewards for block %s: %w", blockId, err)
+	}
+	if status == http.StatusNotFound {
+		return BlockRewardsResponse{}, false, nil
+	}
+	if status == http.StatusNotImplemented {
+		return BlockRewardsResponse{}, false, fmt.Errorf("Could not get block rewards for block %s: %w", blockId, beacon.ErrEndpointNotSupported)
+	}
+	if status != http.StatusOK {
+		return BlockRewardsResponse{}, false, fmt.Errorf("Could not get block rewards for block %s: HTTP status %d; response body: '%s'", blockId, status, string(responseBody))
+	}
+	var rewards BlockRewardsResponse
+	if err := decodeResponse(responseBody, &rewards); err != nil {
+		return BlockRewardsResponse{}, false, fmt.Errorf("Could not decode block rewards for block %s: %w", blockId, err)
+	}
+	return rewards, true, nil
+}
+
+// Get the attestation rewards for an epoch
+func (c *StandardHttpClient) getAttestationRewards(ctx context.Context, epoch uint64, indices []string) (AttestationRewardsResponse, error) {
+	if indices == nil {
+		// An empty list requests the rewards for all validators
+		indices = []string{}
+	}
+	responseBody, status, err := c.postRequest(ctx, fmt.Sprintf(RequestAttestationRewardsPath, strconv.FormatUint(epoch, 10)), indices)
+	if err != nil {
+		return AttestationRewardsResponse{}, fmt.Errorf("Could not get attestation rewards for epoch %d: %w", epoch, err)
+	}
+	if status != http.StatusOK {
+		return AttestationRewardsResponse{}, fmt.Errorf("Could not get attestation rewards for epoch %d: HTTP status %d; response body: '%s'", epoch, status, string(responseBody))
+	}
+	var rewards AttestationRewardsResponse
+	if err := decodeResponse(responseBody, &rewards); err != nil {
+		return AttestationRewardsResponse{}, fmt.Errorf("Could not decode attestation rewards for epoch %d: %w", epoch, err)
+	}
+	return rewards, nil
+}
+
+// Get the sync committee rewards for the target beacon block
+func (c *StandardHttpClient) getSyncCommitteeRewards(ctx context.Context, blockId string, indices []string) (SyncCommitteeRewardsResponse, bool, error) {
+	if indices == nil {
+		// An empty list requests the rewards for the whole committee
+		indices = []string{}
+	}
+	responseBody, status, err := c.postRequest(ctx, fmt.Sprintf(RequestSyncCommitteeRewardsPath, blockId), indices)
+	if err != nil {
+		return SyncCommitteeRewardsResponse{}, false, fmt.Errorf("Could not get sync committee rewards for block %s: %w", blockId, err)
+	}
+	if status == http.StatusNotFound {
+		return SyncCommitteeRewardsResponse{}, false, nil
+	}
+	if status != http.StatusOK {
+		return SyncCommitteeRewardsResponse{}, false, fmt.Errorf("Could not get sync committee rewards for block %s: HTTP status %d; response body: '%s'", blockId, status, string(responseBody))
+	}
+	var rewards SyncCommitteeRewardsResponse
+	if err := decodeResponse(responseBody, &rewards); err != nil {
+		return SyncCommitteeRewardsResponse{}, false, fmt.Errorf("Could not decode sync committee rewards for block %s: %w", blockId, err)
+	}
+	return rewards, true, nil
 }
 
 type committeesDecoder struct {
@@ -813,16 +2334,26 @@ var committeesDecoderPool sync.Pool = sync.Pool{
 }
 
 // Get the committees for the epoch
-func (c *StandardHttpClient) getCommittees(stateId string, epoch *uint64) (CommitteesResponse, error) {
+func (c *StandardHttpClient) getCommittees(ctx context.Context, stateId string, epoch *uint64, index *uint64, slot *uint64) (CommitteesResponse, error) {
 	var committees CommitteesResponse
 
-	query := ""
+	query := url.Values{}
 	if epoch != nil {
-		query = fmt.Sprintf("?epoch=%d", *epoch)
+		query.Set("epoch", strconv.FormatUint(*epoch, 10))
+	}
+	if index != nil {
+		query.Set("index", strconv.FormatUint(*index, 10))
+	}
+	if slot != nil {
+		query.Set("slot", strconv.FormatUint(*slot, 10))
+	}
+	requestPath := fmt.Sprintf(RequestCommitteePath, stateId)
+	if len(query) > 0 {
+		requestPath += "?" + query.Encode()
 	}
 
 	// Committees responses are large, so let the json decoder read it in a buffered fashion
-	reader, status, err := c.getRequestReader(fmt.Sprintf(RequestCommitteePath, stateId) + query)
+	reader, status, err := c.getRequestReader(ctx, requestPath)
 	if err != nil {
 		return CommitteesResponse{}, fmt.Errorf("Could not get committees: %w", err)
 	}
@@ -851,24 +2382,164 @@ func (c *StandardHttpClient) getCommittees(stateId string, epoch *uint64) (Commi
 	return committees, nil
 }
 
+// Get the root of a state
+func (c *StandardHttpClient) getStateRoot(ctx context.Context, stateId string) (StateRootResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestStateRootPath, stateId))
+	if err != nil {
+		return StateRootResponse{}, fmt.Errorf("Could not get state root for state %s: %w", stateId, err)
+	}
+	if status != http.StatusOK {
+		return StateRootResponse{}, fmt.Errorf("Could not get state root for state %s: HTTP status %d; response body: '%s'", stateId, status, string(responseBody))
+	}
+	var root StateRootResponse
+	if err := decodeResponse(responseBody, &root); err != nil {
+		return StateRootResponse{}, fmt.Errorf("Could not decode state root for state %s: %w", stateId, err)
+	}
+	return root, nil
+}
+
+// Get the proposer duties for an epoch
+func (c *StandardHttpClient) getProposerDuties(ctx context.Context, epoch uint64) (ProposerDutiesResponse, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestValidatorProposerDuties, strconv.FormatUint(epoch, 10)))
+	if err != nil {
+		return ProposerDutiesResponse{}, fmt.Errorf("Could not get validator proposer duties: %w", err)
+	}
+	if status != http.StatusOK {
+		return ProposerDutiesResponse{}, fmt.Errorf("Could not get validator proposer duties: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var duties ProposerDutiesResponse
+	if err := decodeResponse(responseBody, &duties); err != nil {
+		return ProposerDutiesResponse{}, fmt.Errorf("Could not decode validator proposer duties data: %w", err)
+	}
+	return duties, nil
+}
+
+// Get the attester duties for an epoch
+func (c *StandardHttpClient) getAttesterDuties(ctx context.Context, epoch uint64, indices []string) (AttesterDutiesResponse, error) {
+	responseBody, status, err := c.postRequest(ctx, fmt.Sprintf(RequestValidatorAttesterDuties, strconv.FormatUint(epoch, 10)), indices)
+	if err != nil {
+		return AttesterDutiesResponse{}, fmt.Errorf("Could not get validator attester duties: %w", err)
+	}
+	if status != http.StatusOK {
+		return AttesterDutiesResponse{}, fmt.Errorf("Could not get validator attester duties: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var duties AttesterDutiesResponse
+	if err := decodeResponse(responseBody, &duties); err != nil {
+		return AttesterDutiesResponse{}, fmt.Errorf("Could not decode validator attester duties data: %w", err)
+	}
+	return duties, nil
+}
+
+// Get the RANDAO mix for a state
+func (c *StandardHttpClient) getRandao(ctx context.Context, stateId string, epoch *uint64) (RandaoResponse, error) {
+	query := ""
+	if epoch != nil {
+		query = fmt.Sprintf("?epoch=%d", *epoch)
+	}
+
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestRandaoPath, stateId)+query)
+	if err != nil {
+		return RandaoResponse{}, fmt.Errorf("Could not get randao for state %s: %w", stateId, err)
+	}
+	if status != http.StatusOK {
+		return RandaoResponse{}, fmt.Errorf("Could not get randao for state %s: HTTP status %d; response body: '%s'", stateId, status, string(responseBody))
+	}
+	var randao RandaoResponse
+	if err := decodeResponse(responseBody, &randao); err != nil {
+		return RandaoResponse{}, fmt.Errorf("Could not decode randao for state %s: %w", stateId, err)
+	}
+	return randao, nil
+}
+
+// Get the header of a block
+func (c *StandardHttpClient) getBlockHeader(ctx context.Context, blockId string) (BlockHeaderResponse, bool, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestBlockHeaderPath, blockId))
+	if err != nil {
+		return BlockHeaderResponse{}, false, fmt.Errorf("Could not get block header for block %s: %w", blockId, err)
+	}
+	if status == http.StatusNotFound {
+		return BlockHeaderResponse{}, false, nil
+	}
+	if status != http.StatusOK {
+		return BlockHeaderResponse{}, false, fmt.Errorf("Could not get block header for block %s: HTTP status %d; response body: '%s'", blockId, status, string(responseBody))
+	}
+	var header BlockHeaderResponse
+	if err := decodeResponse(responseBody, &header); err != nil {
+		return BlockHeaderResponse{}, false, fmt.Errorf("Could not decode block header for block %s: %w", blockId, err)
+	}
+	return header, true, nil
+}
+
+// Get the block headers matching the provided filters
+func (c *StandardHttpClient) getBlockHeaders(ctx context.Context, slot *uint64, parentRoot *common.Hash) (BlockHeadersResponse, error) {
+	query := url.Values{}
+	if slot != nil {
+		query.Set("slot", strconv.FormatUint(*slot, 10))
+	}
+	if parentRoot != nil {
+		query.Set("parent_root", parentRoot.Hex())
+	}
+	requestPath := RequestBlockHeadersPath
+	if len(query) > 0 {
+		requestPath += "?" + query.Encode()
+	}
+	responseBody, status, err := c.getRequest(ctx, requestPath)
+	if err != nil {
+		return BlockHeadersResponse{}, fmt.Errorf("Could not get block headers: %w", err)
+	}
+	if status != http.StatusOK {
+		return BlockHeadersResponse{}, fmt.Errorf("Could not get block headers: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var headers BlockHeadersResponse
+	if err := decodeResponse(responseBody, &headers); err != nil {
+		return BlockHeadersResponse{}, fmt.Errorf("Could not decode block headers: %w", err)
+	}
+	return headers, nil
+}
+
+// Get the sync committee for a state
+func (c *StandardHttpClient) getSyncCommittees(ctx context.Context, stateId string, epoch *uint64) (SyncCommitteesResponse, error) {
+	query := ""
+	if epoch != nil {
+		query = fmt.Sprintf("?epoch=%d", *epoch)
+	}
+
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestSyncCommitteesPath, stateId)+query)
+	if err != nil {
+		return SyncCommitteesResponse{}, fmt.Errorf("Could not get sync committees for state %s: %w", stateId, err)
+	}
+	if status == http.StatusBadRequest && epoch != nil {
+		// Nodes reject epochs past the next sync committee period with a 400
+		return SyncCommitteesResponse{}, fmt.Errorf("Could not get sync committees for epoch %d: %w; response body: '%s'", *epoch, beacon.ErrSyncCommitteePeriodUnavailable, string(responseBody))
+	}
+	if status != http.StatusOK {
+		return SyncCommitteesResponse{}, fmt.Errorf("Could not get sync committees for state %s: HTTP status %d; response body: '%s'", stateId, status, string(responseBody))
+	}
+	var committees SyncCommitteesResponse
+	if err := decodeResponse(responseBody, &committees); err != nil {
+		return SyncCommitteesResponse{}, fmt.Errorf("Could not decode sync committees for state %s: %w", stateId, err)
+	}
+	return committees, nil
+}
+
 // Send withdrawal credentials change request
-func (c *StandardHttpClient) postWithdrawalCredentialsChange(request BLSToExecutionChangeRequest) error {
+func (c *StandardHttpClient) postWithdrawalCredentialsChange(ctx context.Context, request BLSToExecutionChangeRequest) error {
 	requestArray := []BLSToExecutionChangeRequest{request} // This route must be wrapped in an array
-	responseBody, status, err := c.postRequest(RequestWithdrawalCredentialsChangePath, requestArray)
+	responseBody, status, err := c.postRequest(ctx, RequestWithdrawalCredentialsChangePath, requestArray)
 	if err != nil {
-		return fmt.Errorf("Could not broadcast withdrawal credentials change for validator %d: %w", request.Message.ValidatorIndex, err)
+		return fmt.Errorf("Could not broadcast withdrawal credentials change for validator %s: %w", request.Message.ValidatorIndex, err)
 	}
 	if status != http.StatusOK {
-		return fmt.Errorf("Could not broadcast withdrawal credentials change for validator %d: HTTP status %d; response body: '%s'", request.Message.ValidatorIndex, status, string(responseBody))
+		return fmt.Errorf("Could not broadcast withdrawal credentials change for validator %s: HTTP status %d; response body: '%s'", request.Message.ValidatorIndex, status, string(responseBody))
 	}
 	return nil
 }
 
 // Make a GET request but do not read its body yet (allows buffered decoding)
-func (c *StandardHttpClient) getRequestReader(requestPath string) (io.ReadCloser, int, error) {
+func (c *StandardHttpClient) getRequestReader(ctx context.Context, requestPath string) (io.ReadCloser, int, error) {
 
 	// Send request
-	response, err := http.Get(fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath))
+	response, err := c.sendGetRequest(ctx, requestPath, "")
 	if err != nil {
 		return nil, 0, err
 	}
@@ -876,11 +2547,89 @@ func (c *StandardHttpClient) getRequestReader(requestPath string) (io.ReadCloser
 	return response.Body, response.StatusCode, nil
 }
 
+// Make a GET request to the beacon node, and read the body of the response along with its headers
+func (c *StandardHttpClient) getRequestWithHeader(ctx context.Context, requestPath string) ([]byte, int, http.Header, error) {
+
+	// Send request
+	response, err := c.sendGetRequest(ctx, requestPath, "")
+	if err != nil {
+		return []byte{}, 0, nil, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	// Get response
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return []byte{}, 0, nil, err
+	}
+	c.captureRawResponse(ctx, requestPath, response.StatusCode, body)
+
+	// Return
+	return body, response.StatusCode, response.Header, nil
+}
+
+// Send a GET request to the beacon node, with an optional Accept header
+func (c *StandardHttpClient) sendGetRequest(ctx context.Context, requestPath string, accept string) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		response, err := c.sendGetRequestOnce(ctx, requestPath, accept)
+		if attempt >= c.retry.maxAttempts || ctx.Err() != nil || !isRetryable(response, err) {
+			if err == nil && response.StatusCode == http.StatusTooManyRequests {
+				_ = response.Body.Close()
+				return nil, rateLimitedError(response)
+			}
+			return response, err
+		}
+
+		// Wait as long as the node asked if it's rate limiting, or back off otherwise
+		delay := c.retry.delay(attempt)
+		if err == nil && response.StatusCode == http.StatusTooManyRequests {
+			if requested, exists := retryAfter(response, time.Now()); exists {
+				if requested > maxRetryAfterWait {
+					_ = response.Body.Close()
+					return nil, rateLimitedError(response)
+				}
+				delay = requested
+			}
+		}
+
+		// Discard the failed response before trying again
+		c.logRetry(requestPath, attempt, delay, response, err)
+		if response != nil {
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// Send a single GET request
+func (c *StandardHttpClient) sendGetRequestOnce(ctx context.Context, requestPath string, accept string) (*http.Response, error) {
+
+	// Build the request
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+
+	// Send request
+	return c.doRequest(request)
+}
+
 // Make a GET request to the beacon node and read the body of the response
-func (c *StandardHttpClient) getRequest(requestPath string) ([]byte, int, error) {
+func (c *StandardHttpClient) getRequest(ctx context.Context, requestPath string) ([]byte, int, error) {
 
 	// Send request
-	reader, status, err := c.getRequestReader(requestPath)
+	reader, status, err := c.getRequestReader(ctx, requestPath)
 	if err != nil {
 		return []byte{}, 0, err
 	}
@@ -893,13 +2642,14 @@ func (c *StandardHttpClient) getRequest(requestPath string) ([]byte, int, error)
 	if err != nil {
 		return []byte{}, 0, err
 	}
+	c.captureRawResponse(ctx, requestPath, status, body)
 
 	// Return
 	return body, status, nil
 }
 
 // Make a POST request to the beacon node
-func (c *StandardHttpClient) postRequest(requestPath string, requestBody interface{}) ([]byte, int, error) {
+func (c *StandardHttpClient) postRequest(ctx context.Context, requestPath string, requestBody interface{}) ([]byte, int, error) {
 
 	// Get request body
 	requestBodyBytes, err := json.Marshal(requestBody)
@@ -908,22 +2658,78 @@ func (c *StandardHttpClient) postRequest(requestPath string, requestBody interfa
 	}
 	requestBodyReader := bytes.NewReader(requestBodyBytes)
 
+	// Build the request
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), requestBodyReader)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	request.Header.Set("Content-Type", RequestContentType)
+
 	// Send request
-	response, err := http.Post(fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), RequestContentType, requestBodyReader)
+	response, err := c.doRequest(request)
 	if err != nil {
 		return []byte{}, 0, err
 	}
 	defer func() {
 		_ = response.Body.Close()
 	}()
+	if response.StatusCode == http.StatusTooManyRequests {
+		return []byte{}, 0, rateLimitedError(response)
+	}
 
 	// Get response
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
 		return []byte{}, 0, err
 	}
+	c.captureRawResponse(ctx, requestPath, response.StatusCode, body)
 
 	// Return
 	return body, response.StatusCode, nil
 
 }
+
+// Send a request to the Beacon node, asking for a compressed response and transparently decompressing it
+func (c *StandardHttpClient) doRequest(request *http.Request) (*http.Response, error) {
+
+	// Event streams are consumed incrementally, so don't let them get buffered by compression
+	if request.Header.Get("Accept") != RequestEventStreamContentType {
+		request.Header.Set("Accept-Encoding", "gzip")
+	}
+
+	// Identify the client and the request, so they can be matched up with the node's logs
+	request.Header.Set("User-Agent", c.userAgent)
+	request.Header.Set(RequestIdHeader, uuid.NewString())
+	for key, values := range c.headers {
+		request.Header[key] = values
+	}
+
+	// Wait for the rate limit, if there is one
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(request.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Send request
+	start := time.Now()
+	c.logRequestStart(request)
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logRequestEnd(request, nil, start, err)
+		c.observeRequest(request, nil, start, err)
+		return nil, err
+	}
+
+	// Decompress the body if necessary
+	if err := decompressResponse(response); err != nil {
+		_ = response.Body.Close()
+		c.logRequestEnd(request, nil, start, err)
+		c.observeRequest(request, nil, start, err)
+		return nil, err
+	}
+	c.logRequestEnd(request, response, start, nil)
+	c.observeRequest(request, response, start, nil)
+	return response, nil
+
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// Subscribe the node to the sync committee subnets of the provided validators, so it aggregates their gossip until each subscription's epoch.
+// Subscriptions with sync committee indices outside the sync committee are rejected before anything is sent.
+// If the node rejects some of the subscriptions, the returned error is a *beacon.BatchSubmissionError describing each rejection.
+func (c *StandardHttpClient) SubscribeToSyncCommitteeSubnets(ctx context.Context, subscriptions []beacon.SyncCommitteeSubscription) error {
+	ctx, cancel := c.methodContext(ctx, "SubscribeToSyncCommitteeSubnets")
+	defer cancel()
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	requests := make([]SyncCommitteeSubscriptionRequest, len(subscriptions))
+	for i, subscription := range subscriptions {
+		if len(subscription.SyncCommitteeIndices) == 0 {
+			return fmt.Errorf("Could not subscribe to sync committee subnets: validator %s does not have any sync committee indices", subscription.ValidatorIndex)
+		}
+		indices := make([]uinteger, len(subscription.SyncCommitteeIndices))
+		for j, index := range subscription.SyncCommitteeIndices {
+			if index >= SyncCommitteeSize {
+				return fmt.Errorf("Could not subscribe to sync committee subnets: sync committee index %d for validator %s is out of range (the sync committee has %d members)", index, subscription.ValidatorIndex, SyncCommitteeSize)
+			}
+			indices[j] = uinteger(index)
+		}
+		requests[i] = SyncCommitteeSubscriptionRequest{
+			ValidatorIndex:       subscription.ValidatorIndex,
+			SyncCommitteeIndices: indices,
+			UntilEpoch:           uinteger(subscription.UntilEpoch),
+		}
+	}
+	return c.postSyncCommitteeSubscriptions(ctx, requests)
+}
+
+// Send sync committee subscriptions
+func (c *StandardHttpClient) postSyncCommitteeSubscriptions(ctx context.Context, requests []SyncCommitteeSubscriptionRequest) error {
+	responseBody, status, err := c.postRequest(ctx, RequestSyncCommitteeSubscriptionsPath, requests)
+	if err != nil {
+		return fmt.Errorf("Could not subscribe to sync committee subnets: %w", err)
+	}
+	if status == http.StatusBadRequest {
+		if batchErr := parseBatchSubmissionError(responseBody, "Could not subscribe to sync committee subnets"); batchErr != nil {
+			return batchErr
+		}
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Could not subscribe to sync committee subnets: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	return nil
+}
+
+// Subscribe the node to the attestation subnets of the provided validators' beacon committees.
+// Aggregators are subscribed to the whole subnet so they can aggregate it; other validators only need the node to announce the subnet.
+// If the node rejects some of the subscriptions, the returned error is a *beacon.BatchSubmissionError describing each rejection.
+func (c *StandardHttpClient) SubscribeToBeaconCommitteeSubnets(ctx context.Context, subscriptions []beacon.BeaconCommitteeSubscription) error {
+	ctx, cancel := c.methodContext(ctx, "SubscribeToBeaconCommitteeSubnets")
+	defer cancel()
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	requests := make([]BeaconCommitteeSubscriptionRequest, len(subscriptions))
+	for i, subscription := range subscriptions {
+		if subscription.CommitteeIndex >= subscription.CommitteesAtSlot {
+			return fmt.Errorf("Could not subscribe to beacon committee subnets: committee index %d for validator %s is out of range (slot %d has %d committees)", subscription.CommitteeIndex, subscription.ValidatorIndex, subscription.Slot, subscription.CommitteesAtSlot)
+		}
+		requests[i] = BeaconCommitteeSubscriptionRequest{
+			ValidatorIndex:   subscription.ValidatorIndex,
+			CommitteeIndex:   uinteger(subscription.CommitteeIndex),
+			CommitteesAtSlot: uinteger(subscription.CommitteesAtSlot),
+			Slot:             uinteger(subscription.Slot),
+			IsAggregator:     subscription.IsAggregator,
+		}
+	}
+	return c.postBeaconCommitteeSubscriptions(ctx, requests)
+}
+
+// Send beacon committee subscriptions
+func (c *StandardHttpClient) postBeaconCommitteeSubscriptions(ctx context.Context, requests []BeaconCommitteeSubscriptionRequest) error {
+	responseBody, status, err := c.postRequest(ctx, RequestCommitteeSubscriptionsPath, requests)
+	if err != nil {
+		return fmt.Errorf("Could not subscribe to beacon committee subnets: %w", err)
+	}
+	if status == http.StatusBadRequest {
+		if batchErr := parseBatchSubmissionError(responseBody, "Could not subscribe to beacon committee subnets"); batchErr != nil {
+			return batchErr
+		}
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Could not subscribe to beacon committee subnets: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	return nil
+}
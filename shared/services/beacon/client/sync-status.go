@@ -0,0 +1,31 @@
+package client
+
+import "time"
+
+// Check if the node can be relied on to serve requests: it must be synced, have its execution client online,
+// and not be optimistically imported (where its execution payloads haven't been verified yet)
+func (r *SyncStatusResponse) IsUsable() bool {
+	return !r.Data.IsSyncing && !r.Data.IsOptimistic && !r.Data.ElOffline
+}
+
+// Returned by EstimateSyncETA when the node isn't catching up, so no estimate can be made
+const SyncETAUnknown time.Duration = -1
+
+// Estimate how long the node will take to finish syncing, based on how much its sync distance shrank since a previous poll
+// taken elapsed ago. The sync distance already accounts for new slots being added to the chain, so the slot time doesn't
+// need to be factored in separately. Returns SyncETAUnknown if the distance didn't shrink between the two polls.
+func (r *SyncStatusResponse) EstimateSyncETA(previous SyncStatusResponse, elapsed time.Duration) time.Duration {
+	distance := uint64(r.Data.SyncDistance)
+	if distance == 0 {
+		return 0
+	}
+
+	previousDistance := uint64(previous.Data.SyncDistance)
+	if previousDistance <= distance || elapsed <= 0 {
+		return SyncETAUnknown
+	}
+
+	// Extrapolate the rate the distance is shrinking at
+	caughtUp := previousDistance - distance
+	return time.Duration(float64(elapsed) * float64(distance) / float64(caughtUp))
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// Use tlsConfig for HTTPS connections to the Beacon node, e.g. to trust a private CA or to present a client certificate.
+// Without this, the system's root CAs are used as usual.
+func WithTLSConfig(tlsConfig *tls.Config) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		c.tlsConfig = tlsConfig
+	}
+}
+
+// Build a TLS config for WithTLSConfig from PEM files.
+// caFile adds a CA to trust on top of the system roots; certFile and keyFile set a client certificate for mutual TLS.
+// Any of them can be left empty. insecureSkipVerify disables server certificate verification and should only be used for testing.
+func LoadTLSConfig(caFile string, certFile string, keyFile string, insecureSkipVerify bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	// Load the CA
+	if caFile != "" {
+		caPem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA file [%s]: %w", caFile, err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caPem) {
+			return nil, fmt.Errorf("CA file [%s] does not contain any PEM certificates", caFile)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	// Load the client certificate
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, fmt.Errorf("a client certificate requires both a certificate file and a key file")
+		}
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate [%s]: %w", certFile, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
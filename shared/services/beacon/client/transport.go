@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// Connection pooling settings for the client's transport; zero values keep the defaults
+type TransportOptions struct {
+	MaxIdleConns        int           // The most idle connections kept open in total
+	MaxIdleConnsPerHost int           // The most idle connections kept open to the Beacon node; defaults to the client's concurrency limit
+	IdleConnTimeout     time.Duration // How long an idle connection is kept before it's closed
+	DisableHTTP2        bool          // HTTP/2 is negotiated by default over HTTPS; this forces HTTP/1.1 instead
+	ForceHTTP2          bool          // Use HTTP/2 even with a custom TLS config, and over plain HTTP (h2c); ignored if DisableHTTP2 is set
+}
+
+// Tune the connection pool of the client's transport, e.g. for a remote Beacon node serving large responses
+func WithTransportOptions(options TransportOptions) StandardHttpClientOption {
+	return func(c *StandardHttpClient) {
+		c.transportOptions = options
+	}
+}
+
+// Build the HTTP client used for every request, so connections are pooled across all of them.
+// Without a per-host idle limit at least as high as the number of concurrent requests, parallel fetches would keep opening new connections.
+// There's no client-wide timeout, since event streams stay open indefinitely; request lifetimes are bounded by their contexts instead.
+func (c *StandardHttpClient) buildHttpClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = threadLimit
+	if c.transportOptions.MaxIdleConns > 0 {
+		transport.MaxIdleConns = c.transportOptions.MaxIdleConns
+	}
+	if c.transportOptions.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = c.transportOptions.MaxIdleConnsPerHost
+	}
+	if c.transportOptions.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = c.transportOptions.IdleConnTimeout
+	}
+	if c.tlsConfig != nil {
+		transport.TLSClientConfig = c.tlsConfig
+	}
+	if c.transportOptions.DisableHTTP2 {
+		// A non-nil, empty TLSNextProto map stops the transport from upgrading to HTTP/2
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	} else if c.transportOptions.ForceHTTP2 {
+		if strings.HasPrefix(strings.ToLower(c.providerAddress), "http://") {
+			return &http.Client{
+				Transport: buildH2cTransport(),
+			}
+		}
+		transport.ForceAttemptHTTP2 = true
+	}
+	return &http.Client{
+		Transport: transport,
+	}
+}
+
+// Build a transport that speaks HTTP/2 over plain TCP with prior knowledge (h2c), for Beacon nodes that serve HTTP/2 without TLS.
+// All requests are multiplexed over a single connection, so the idle connection settings don't apply to it.
+func buildH2cTransport() *http2.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	return &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network string, addr string, _ *tls.Config) (net.Conn, error) {
+			// The transport always dials through this, but the scheme is http so there's no TLS to negotiate
+			return dialer.DialContext(ctx, network, addr)
+		},
+	}
+}
@@ -2,7 +2,12 @@ package client
 
 import (
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
@@ -28,6 +33,45 @@ type BLSToExecutionChangeRequest struct {
 	Message   BLSToExecutionChangeMessage `json:"message"`
 	Signature byteArray                   `json:"signature"`
 }
+type Checkpoint struct {
+	Epoch uinteger  `json:"epoch"`
+	Root  byteArray `json:"root"`
+}
+type AttestationData struct {
+	Slot            uinteger   `json:"slot"`
+	Index           uinteger   `json:"index"`
+	BeaconBlockRoot byteArray  `json:"beacon_block_root"`
+	Source          Checkpoint `json:"source"`
+	Target          Checkpoint `json:"target"`
+}
+type IndexedAttestation struct {
+	AttestingIndices []string        `json:"attesting_indices"`
+	Data             AttestationData `json:"data"`
+	Signature        byteArray       `json:"signature"`
+}
+type AttesterSlashing struct {
+	Attestation1 IndexedAttestation `json:"attestation_1"`
+	Attestation2 IndexedAttestation `json:"attestation_2"`
+}
+type BeaconBlockHeader struct {
+	Slot          uinteger  `json:"slot"`
+	ProposerIndex string    `json:"proposer_index"`
+	ParentRoot    byteArray `json:"parent_root"`
+	StateRoot     byteArray `json:"state_root"`
+	BodyRoot      byteArray `json:"body_root"`
+}
+type SignedBeaconBlockHeader struct {
+	Message   BeaconBlockHeader `json:"message"`
+	Signature byteArray         `json:"signature"`
+}
+type ProposerSlashing struct {
+	SignedHeader1 SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+type ValidatorsRequest struct {
+	Ids      []string          `json:"ids,omitempty"`
+	Statuses []ValidatorStatus `json:"statuses,omitempty"`
+}
 
 // Response types
 type SyncStatusResponse struct {
@@ -35,13 +79,67 @@ type SyncStatusResponse struct {
 		IsSyncing    bool     `json:"is_syncing"`
 		HeadSlot     uinteger `json:"head_slot"`
 		SyncDistance uinteger `json:"sync_distance"`
+
+		// Older nodes don't report these, in which case they're left false
+		IsOptimistic bool `json:"is_optimistic"`
+		ElOffline    bool `json:"el_offline"`
+	} `json:"data"`
+}
+type NodeVersionResponse struct {
+	Data struct {
+		Version string `json:"version"`
+	} `json:"data"`
+}
+type NodeIdentityResponse struct {
+	Data struct {
+		PeerID             string   `json:"peer_id"`
+		Enr                string   `json:"enr"`
+		P2PAddresses       []string `json:"p2p_addresses"`
+		DiscoveryAddresses []string `json:"discovery_addresses"`
+		Metadata           struct {
+			SeqNumber uinteger  `json:"seq_number"`
+			Attnets   byteArray `json:"attnets"`
+			Syncnets  byteArray `json:"syncnets"`
+		} `json:"metadata"`
+	} `json:"data"`
+}
+type PeerCountResponse struct {
+	Data struct {
+		Disconnected  uinteger `json:"disconnected"`
+		Connecting    uinteger `json:"connecting"`
+		Connected     uinteger `json:"connected"`
+		Disconnecting uinteger `json:"disconnecting"`
+	} `json:"data"`
+}
+type PeersResponse struct {
+	Data []struct {
+		PeerID             string `json:"peer_id"`
+		Enr                string `json:"enr"`
+		LastSeenP2PAddress string `json:"last_seen_p2p_address"`
+		State              string `json:"state"`
+		Direction          string `json:"direction"`
 	} `json:"data"`
 }
 type Eth2ConfigResponse struct {
 	Data struct {
-		SecondsPerSlot               uinteger `json:"SECONDS_PER_SLOT"`
-		SlotsPerEpoch                uinteger `json:"SLOTS_PER_EPOCH"`
-		EpochsPerSyncCommitteePeriod uinteger `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+		SecondsPerSlot                      uinteger `json:"SECONDS_PER_SLOT"`
+		SlotsPerEpoch                       uinteger `json:"SLOTS_PER_EPOCH"`
+		SlotsPerHistoricalRoot              uinteger `json:"SLOTS_PER_HISTORICAL_ROOT"`
+		EpochsPerHistoricalVector           uinteger `json:"EPOCHS_PER_HISTORICAL_VECTOR"`
+		EpochsPerSlashingsVector            uinteger `json:"EPOCHS_PER_SLASHINGS_VECTOR"`
+		EpochsPerSyncCommitteePeriod        uinteger `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+		ShardCommitteePeriod                uinteger `json:"SHARD_COMMITTEE_PERIOD"`
+		MinValidatorWithdrawabilityDelay    uinteger `json:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY"`
+		ChurnLimitQuotient                  uinteger `json:"CHURN_LIMIT_QUOTIENT"`
+		MinPerEpochChurnLimit               uinteger `json:"MIN_PER_EPOCH_CHURN_LIMIT"`
+		MaxPerEpochActivationChurnLimit     uinteger `json:"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT"`
+		MaxEffectiveBalance                 uinteger `json:"MAX_EFFECTIVE_BALANCE"`
+		EjectionBalance                     uinteger `json:"EJECTION_BALANCE"`
+		EffectiveBalanceIncrement           uinteger `json:"EFFECTIVE_BALANCE_INCREMENT"`
+		MinPerEpochChurnLimitElectra        uinteger `json:"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA"`
+		MaxPerEpochActivationExitChurnLimit uinteger `json:"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT"`
+		DenebForkEpoch                      uinteger `json:"DENEB_FORK_EPOCH"`
+		ElectraForkEpoch                    uinteger `json:"ELECTRA_FORK_EPOCH"`
 	} `json:"data"`
 }
 type Eth2DepositContractResponse struct {
@@ -50,36 +148,112 @@ type Eth2DepositContractResponse struct {
 		Address common.Address `json:"address"`
 	} `json:"data"`
 }
+type DepositSnapshotResponse struct {
+	Data struct {
+		Finalized            []byteArray `json:"finalized"`
+		DepositRoot          byteArray   `json:"deposit_root"`
+		DepositCount         uinteger    `json:"deposit_count"`
+		ExecutionBlockHash   byteArray   `json:"execution_block_hash"`
+		ExecutionBlockHeight uinteger    `json:"execution_block_height"`
+	} `json:"data"`
+}
 type GenesisResponse struct {
 	Data struct {
-		GenesisTime           uinteger  `json:"genesis_time"`
-		GenesisForkVersion    byteArray `json:"genesis_fork_version"`
-		GenesisValidatorsRoot byteArray `json:"genesis_validators_root"`
+		GenesisTime           uinteger    `json:"genesis_time"`
+		GenesisForkVersion    byteArray   `json:"genesis_fork_version"`
+		GenesisValidatorsRoot byteArray32 `json:"genesis_validators_root"`
 	} `json:"data"`
 }
 type FinalityCheckpointsResponse struct {
 	Data struct {
-		PreviousJustified struct {
-			Epoch uinteger `json:"epoch"`
-		} `json:"previous_justified"`
-		CurrentJustified struct {
-			Epoch uinteger `json:"epoch"`
-		} `json:"current_justified"`
-		Finalized struct {
-			Epoch uinteger `json:"epoch"`
-		} `json:"finalized"`
+		PreviousJustified Checkpoint `json:"previous_justified"`
+		CurrentJustified  Checkpoint `json:"current_justified"`
+		Finalized         Checkpoint `json:"finalized"`
 	} `json:"data"`
 }
-type ForkResponse struct {
+type StateRootResponse struct {
+	Data struct {
+		Root byteArray `json:"root"`
+	} `json:"data"`
+}
+type RandaoResponse struct {
 	Data struct {
-		PreviousVersion byteArray `json:"previous_version"`
-		CurrentVersion  byteArray `json:"current_version"`
-		Epoch           uinteger  `json:"epoch"`
+		Randao byteArray `json:"randao"`
 	} `json:"data"`
 }
+type ForkResponse struct {
+	Data Fork `json:"data"`
+}
+type ForkScheduleResponse struct {
+	Data []Fork `json:"data"`
+}
+type Fork struct {
+	PreviousVersion byteArray `json:"previous_version"`
+	CurrentVersion  byteArray `json:"current_version"`
+	Epoch           uinteger  `json:"epoch"`
+}
+type VoluntaryExitsResponse struct {
+	Data []VoluntaryExitRequest `json:"data"`
+}
+type BLSToExecutionChangesResponse struct {
+	Data []BLSToExecutionChangeRequest `json:"data"`
+}
+type BlockHeaderResponse struct {
+	Data BlockHeader `json:"data"`
+}
+type BlockHeadersResponse struct {
+	Data []BlockHeader `json:"data"`
+}
+type BlockHeader struct {
+	Root      byteArray               `json:"root"`
+	Canonical bool                    `json:"canonical"`
+	Header    SignedBeaconBlockHeader `json:"header"`
+}
+type AttesterSlashingsResponse struct {
+	Data []AttesterSlashing `json:"data"`
+}
+type ProposerSlashingsResponse struct {
+	Data []ProposerSlashing `json:"data"`
+}
 type AttestationsResponse struct {
 	Data []Attestation `json:"data"`
 }
+type BlockRewardsResponse struct {
+	Data struct {
+		ProposerIndex     string   `json:"proposer_index"`
+		Total             uinteger `json:"total"`
+		Attestations      uinteger `json:"attestations"`
+		SyncAggregate     uinteger `json:"sync_aggregate"`
+		ProposerSlashings uinteger `json:"proposer_slashings"`
+		AttesterSlashings uinteger `json:"attester_slashings"`
+	} `json:"data"`
+}
+type AttestationRewardsResponse struct {
+	Data struct {
+		IdealRewards []struct {
+			EffectiveBalance uinteger `json:"effective_balance"`
+			Head             sinteger `json:"head"`
+			Target           sinteger `json:"target"`
+			Source           sinteger `json:"source"`
+			InclusionDelay   sinteger `json:"inclusion_delay"`
+			Inactivity       sinteger `json:"inactivity"`
+		} `json:"ideal_rewards"`
+		TotalRewards []struct {
+			ValidatorIndex string   `json:"validator_index"`
+			Head           sinteger `json:"head"`
+			Target         sinteger `json:"target"`
+			Source         sinteger `json:"source"`
+			InclusionDelay sinteger `json:"inclusion_delay"`
+			Inactivity     sinteger `json:"inactivity"`
+		} `json:"total_rewards"`
+	} `json:"data"`
+}
+type SyncCommitteeRewardsResponse struct {
+	Data []struct {
+		ValidatorIndex string   `json:"validator_index"`
+		Reward         sinteger `json:"reward"`
+	} `json:"data"`
+}
 type BeaconBlockResponse struct {
 	Data struct {
 		Message struct {
@@ -91,68 +265,267 @@ type BeaconBlockResponse struct {
 					DepositCount uinteger  `json:"deposit_count"`
 					BlockHash    byteArray `json:"block_hash"`
 				} `json:"eth1_data"`
-				Attestations     []Attestation `json:"attestations"`
-				ExecutionPayload *struct {
-					FeeRecipient byteArray `json:"fee_recipient"`
-					BlockNumber  uinteger  `json:"block_number"`
-				} `json:"execution_payload"`
+				Graffiti         byteArray         `json:"graffiti"`
+				Attestations     []Attestation     `json:"attestations"`
+				ExecutionPayload *ExecutionPayload `json:"execution_payload"`
 			} `json:"body"`
 		} `json:"message"`
 	} `json:"data"`
 }
+
+// The execution payload is nil for blocks from before the merge, so it must be nil-checked before use
+type ExecutionPayload struct {
+	FeeRecipient  byteArray  `json:"fee_recipient"`
+	BlockNumber   uinteger   `json:"block_number"`
+	GasLimit      uinteger   `json:"gas_limit"`
+	GasUsed       uinteger   `json:"gas_used"`
+	Timestamp     uinteger   `json:"timestamp"`
+	BaseFeePerGas bigInteger `json:"base_fee_per_gas"`
+	BlockHash     byteArray  `json:"block_hash"`
+
+	// Only the number of transactions is kept, since decoding them is expensive and rarely needed
+	Transactions itemCount `json:"transactions"`
+
+	// Capella fields; absent in earlier blocks
+	Withdrawals []Withdrawal `json:"withdrawals"`
+
+	// Deneb fields; these are absent in earlier blocks and default to zero
+	BlobGasUsed   uinteger `json:"blob_gas_used"`
+	ExcessBlobGas uinteger `json:"excess_blob_gas"`
+}
 type ValidatorsResponse struct {
 	Data []Validator `json:"data"`
+
+	// Lookup index over Data, built on demand
+	index       map[string]*Validator
+	indexedData []Validator
+}
+type ValidatorResponse struct {
+	Data Validator `json:"data"`
 }
 type Validator struct {
-	Index     string   `json:"index"`
-	Balance   uinteger `json:"balance"`
-	Status    string   `json:"status"`
+	Index     string          `json:"index"`
+	Balance   uinteger        `json:"balance"`
+	Status    ValidatorStatus `json:"status"`
 	Validator struct {
-		Pubkey                     byteArray `json:"pubkey"`
-		WithdrawalCredentials      byteArray `json:"withdrawal_credentials"`
-		EffectiveBalance           uinteger  `json:"effective_balance"`
-		Slashed                    bool      `json:"slashed"`
-		ActivationEligibilityEpoch uinteger  `json:"activation_eligibility_epoch"`
-		ActivationEpoch            uinteger  `json:"activation_epoch"`
-		ExitEpoch                  uinteger  `json:"exit_epoch"`
-		WithdrawableEpoch          uinteger  `json:"withdrawable_epoch"`
+		Pubkey                     byteArray48 `json:"pubkey"`
+		WithdrawalCredentials      byteArray32 `json:"withdrawal_credentials"`
+		EffectiveBalance           uinteger    `json:"effective_balance"`
+		Slashed                    bool        `json:"slashed"`
+		ActivationEligibilityEpoch uinteger    `json:"activation_eligibility_epoch"`
+		ActivationEpoch            uinteger    `json:"activation_epoch"`
+		ExitEpoch                  uinteger    `json:"exit_epoch"`
+		WithdrawableEpoch          uinteger    `json:"withdrawable_epoch"`
 	} `json:"validator"`
 }
+type ValidatorBalancesResponse struct {
+	Data []ValidatorBalance `json:"data"`
+}
+type ValidatorBalance struct {
+	Index   string   `json:"index"`
+	Balance uinteger `json:"balance"`
+}
+type PendingDepositsResponse struct {
+	Data []PendingDeposit `json:"data"`
+}
+type PendingDeposit struct {
+	Pubkey byteArray48 `json:"pubkey"`
+	Amount uinteger    `json:"amount"`
+	Slot   uinteger    `json:"slot"`
+}
+type ExpectedWithdrawalsResponse struct {
+	Data []Withdrawal `json:"data"`
+}
+type Withdrawal struct {
+	Index          uinteger       `json:"index"`
+	ValidatorIndex string         `json:"validator_index"`
+	Address        common.Address `json:"address"`
+	Amount         uinteger       `json:"amount"`
+}
+type ValidatorLivenessResponse struct {
+	Data []struct {
+		Index  string `json:"index"`
+		IsLive bool   `json:"is_live"`
+	} `json:"data"`
+}
+
+// Sync duties don't have a dependent root, since they're fixed for the whole sync committee period
 type SyncDutiesResponse struct {
-	Data []SyncDuty `json:"data"`
+	ExecutionOptimistic bool       `json:"execution_optimistic"`
+	Data                []SyncDuty `json:"data"`
+
+	// Lookup index over Data, built on demand
+	index       map[string]*SyncDuty
+	indexedData []SyncDuty
 }
 type SyncDuty struct {
-	Pubkey               byteArray  `json:"pubkey"`
-	ValidatorIndex       string     `json:"validator_index"`
-	SyncCommitteeIndices []uinteger `json:"validator_sync_committee_indices"`
+	Pubkey               byteArray48 `json:"pubkey"`
+	ValidatorIndex       string      `json:"validator_index"`
+	SyncCommitteeIndices []uinteger  `json:"validator_sync_committee_indices"`
 }
 type ProposerDutiesResponse struct {
-	Data []ProposerDuty `json:"data"`
+	DependentRoot       byteArray      `json:"dependent_root"`
+	ExecutionOptimistic bool           `json:"execution_optimistic"`
+	Data                []ProposerDuty `json:"data"`
 }
 type ProposerDuty struct {
-	ValidatorIndex string `json:"validator_index"`
+	Pubkey         byteArray48 `json:"pubkey"`
+	ValidatorIndex string      `json:"validator_index"`
+	Slot           uinteger    `json:"slot"`
+}
+type AttesterDutiesResponse struct {
+	DependentRoot       byteArray      `json:"dependent_root"`
+	ExecutionOptimistic bool           `json:"execution_optimistic"`
+	Data                []AttesterDuty `json:"data"`
+}
+type AttesterDuty struct {
+	Pubkey                  byteArray48 `json:"pubkey"`
+	ValidatorIndex          string      `json:"validator_index"`
+	CommitteeIndex          uinteger    `json:"committee_index"`
+	CommitteeLength         uinteger    `json:"committee_length"`
+	CommitteesAtSlot        uinteger    `json:"committees_at_slot"`
+	ValidatorCommitteeIndex uinteger    `json:"validator_committee_index"`
+	Slot                    uinteger    `json:"slot"`
 }
 
 type CommitteesResponse struct {
 	Data []Committee `json:"data"`
+
+	// Lookup index of each validator's position, built on demand
+	positions map[string]committeePosition
+}
+type SyncCommitteesResponse struct {
+	Data struct {
+		Validators          []string   `json:"validators"`
+		ValidatorAggregates [][]string `json:"validator_aggregates"`
+	} `json:"data"`
+}
+type BlobSidecarsResponse struct {
+	Data []BlobSidecar `json:"data"`
+}
+type BlobSidecar struct {
+	Index             uinteger  `json:"index"`
+	Blob              byteArray `json:"blob"`
+	KzgCommitment     byteArray `json:"kzg_commitment"`
+	KzgProof          byteArray `json:"kzg_proof"`
+	SignedBlockHeader struct {
+		Message struct {
+			Slot          uinteger  `json:"slot"`
+			ProposerIndex string    `json:"proposer_index"`
+			ParentRoot    byteArray `json:"parent_root"`
+			StateRoot     byteArray `json:"state_root"`
+			BodyRoot      byteArray `json:"body_root"`
+		} `json:"message"`
+		Signature byteArray `json:"signature"`
+	} `json:"signed_block_header"`
+	KzgCommitmentInclusionProof []byteArray `json:"kzg_commitment_inclusion_proof"`
 }
 
 type Attestation struct {
-	AggregationBits string `json:"aggregation_bits"`
-	Data            struct {
-		Slot  uinteger `json:"slot"`
-		Index uinteger `json:"index"`
+	AggregationBits string          `json:"aggregation_bits"`
+	Data            AttestationData `json:"data"`
+	Signature       byteArray       `json:"signature"`
+}
+type ProduceBlockResponse struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+type BlindedBlockHeader struct {
+	Body struct {
+		ExecutionPayloadHeader struct {
+			FeeRecipient byteArray `json:"fee_recipient"`
+			GasLimit     uinteger  `json:"gas_limit"`
+		} `json:"execution_payload_header"`
+	} `json:"body"`
+}
+type ProposerPreparationRequest struct {
+	ValidatorIndex string    `json:"validator_index"`
+	FeeRecipient   byteArray `json:"fee_recipient"`
+}
+type SignedValidatorRegistrationRequest struct {
+	Message struct {
+		FeeRecipient byteArray `json:"fee_recipient"`
+		GasLimit     uinteger  `json:"gas_limit"`
+		Timestamp    uinteger  `json:"timestamp"`
+		Pubkey       byteArray `json:"pubkey"`
+	} `json:"message"`
+	Signature byteArray `json:"signature"`
+}
+type SyncCommitteeMessageRequest struct {
+	Slot            uinteger  `json:"slot"`
+	BeaconBlockRoot byteArray `json:"beacon_block_root"`
+	ValidatorIndex  string    `json:"validator_index"`
+	Signature       byteArray `json:"signature"`
+}
+type SyncCommitteeSubscriptionRequest struct {
+	ValidatorIndex       string     `json:"validator_index"`
+	SyncCommitteeIndices []uinteger `json:"sync_committee_indices"`
+	UntilEpoch           uinteger   `json:"until_epoch"`
+}
+type BeaconCommitteeSubscriptionRequest struct {
+	ValidatorIndex   string   `json:"validator_index"`
+	CommitteeIndex   uinteger `json:"committee_index"`
+	CommitteesAtSlot uinteger `json:"committees_at_slot"`
+	Slot             uinteger `json:"slot"`
+	IsAggregator     bool     `json:"is_aggregator"`
+}
+type IndexedErrorResponse struct {
+	Code     int    `json:"code"`
+	Message  string `json:"message"`
+	Failures []struct {
+		Index   uinteger `json:"index"`
+		Message string   `json:"message"`
+	} `json:"failures"`
+}
+type FeeRecipientResponse struct {
+	Data struct {
+		Pubkey     byteArray `json:"pubkey"`
+		EthAddress byteArray `json:"ethaddress"`
 	} `json:"data"`
 }
+type AttestationDataResponse struct {
+	Data AttestationData `json:"data"`
+}
+type AggregateAttestationResponse struct {
+	Data Attestation `json:"data"`
+}
 
 // Unsigned integer type
 type uinteger uint64
 
 func (i uinteger) MarshalJSON() ([]byte, error) {
-	return json.Marshal(strconv.Itoa(int(i)))
+	return json.Marshal(strconv.FormatUint(uint64(i), 10))
 }
 func (i *uinteger) UnmarshalJSON(data []byte) error {
 
+	// Unmarshal string; some nodes send bare numbers instead, which can be parsed as-is
+	dataStr := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &dataStr); err != nil {
+			return err
+		}
+	}
+
+	// Parse integer value
+	value, err := strconv.ParseUint(dataStr, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	// Set value and return
+	*i = uinteger(value)
+	return nil
+
+}
+
+// Signed integer type
+type sinteger int64
+
+func (i sinteger) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatInt(int64(i), 10))
+}
+func (i *sinteger) UnmarshalJSON(data []byte) error {
+
 	// Unmarshal string
 	var dataStr string
 	if err := json.Unmarshal(data, &dataStr); err != nil {
@@ -160,17 +533,60 @@ func (i *uinteger) UnmarshalJSON(data []byte) error {
 	}
 
 	// Parse integer value
-	value, err := strconv.ParseUint(dataStr, 10, 64)
+	value, err := strconv.ParseInt(dataStr, 10, 64)
 	if err != nil {
 		return err
 	}
 
 	// Set value and return
-	*i = uinteger(value)
+	*i = sinteger(value)
 	return nil
 
 }
 
+// Arbitrary-precision unsigned integer type, for values that may not fit in a uint64
+type bigInteger struct {
+	*big.Int
+}
+
+func (i bigInteger) MarshalJSON() ([]byte, error) {
+	if i.Int == nil {
+		return json.Marshal("0")
+	}
+	return json.Marshal(i.Int.String())
+}
+func (i *bigInteger) UnmarshalJSON(data []byte) error {
+
+	// Unmarshal string
+	var dataStr string
+	if err := json.Unmarshal(data, &dataStr); err != nil {
+		return err
+	}
+
+	// Parse integer value
+	value, ok := new(big.Int).SetString(dataStr, 10)
+	if !ok {
+		return fmt.Errorf("invalid integer value '%s'", dataStr)
+	}
+
+	// Set value and return
+	i.Int = value
+	return nil
+
+}
+
+// Count of the items in a JSON array, for when the items themselves aren't needed
+type itemCount uint64
+
+func (c *itemCount) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*c = itemCount(len(items))
+	return nil
+}
+
 // Byte array type
 type byteArray []byte
 
@@ -185,10 +601,21 @@ func (b *byteArray) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	// Decode hex
-	value, err := hex.DecodeString(hexutil.RemovePrefix(dataStr))
+	// Decode hex; the prefix is optional and may be in either case
+	hexStr := dataStr
+	if len(hexStr) >= 2 && hexStr[0] == '0' && (hexStr[1] == 'x' || hexStr[1] == 'X') {
+		hexStr = hexStr[2:]
+	}
+	if len(hexStr)%2 != 0 {
+		return &hexValueError{value: dataStr, reason: fmt.Sprintf("odd number of digits (%d)", len(hexStr))}
+	}
+	value, err := hex.DecodeString(hexStr)
 	if err != nil {
-		return err
+		var invalidByte hex.InvalidByteError
+		if errors.As(err, &invalidByte) {
+			return &hexValueError{value: dataStr, reason: fmt.Sprintf("non-hex character %q", rune(invalidByte))}
+		}
+		return &hexValueError{value: dataStr, reason: err.Error()}
 	}
 
 	// Set value and return
@@ -196,3 +623,136 @@ func (b *byteArray) UnmarshalJSON(data []byte) error {
 	return nil
 
 }
+
+// Byte array types with a fixed length, for roots, credentials, and pubkeys
+type byteArray32 []byte
+type byteArray48 []byte
+
+func (b byteArray32) MarshalJSON() ([]byte, error) {
+	return byteArray(b).MarshalJSON()
+}
+func (b *byteArray32) UnmarshalJSON(data []byte) error {
+	return (*byteArray)(b).unmarshalFixedLength(data, 32)
+}
+func (b byteArray48) MarshalJSON() ([]byte, error) {
+	return byteArray(b).MarshalJSON()
+}
+func (b *byteArray48) UnmarshalJSON(data []byte) error {
+	return (*byteArray)(b).unmarshalFixedLength(data, 48)
+}
+
+// Unmarshal a byte array, checking that it has the expected length
+func (b *byteArray) unmarshalFixedLength(data []byte, length int) error {
+	var value byteArray
+	if err := value.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	if len(value) != length {
+		return &hexValueError{value: hexutil.AddPrefix(hex.EncodeToString(value)), reason: fmt.Sprintf("expected %d bytes but got %d", length, len(value))}
+	}
+	*b = value
+	return nil
+}
+
+// Shorten a long hex string for use in error messages
+func abbreviateHex(value string) string {
+	const maxLength = 24
+	if len(value) <= maxLength {
+		return value
+	}
+	return value[:maxLength/2] + "..." + value[len(value)-maxLength/2:]
+}
+
+// Error for a hex value that couldn't be decoded into a byte array
+type hexValueError struct {
+	value  string
+	reason string
+}
+
+func (e *hexValueError) Error() string {
+	return fmt.Sprintf("invalid hex value '%s': %s", abbreviateHex(e.value), e.reason)
+}
+
+// Unmarshal a JSON response; if a hex value in it can't be decoded, the error is prefixed with the JSON path of its field
+// (for example "data[3].validator.withdrawal_credentials") since the value alone doesn't say where it came from
+func decodeResponse(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	var hexErr *hexValueError
+	if err == nil || !errors.As(err, &hexErr) {
+		return err
+	}
+	if path := failedFieldPath(data, reflect.TypeOf(v)); path != "" {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return err
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// Get the JSON path, relative to data, of the first field that fails to decode into t.
+// This re-decodes each level of the value, so it should only be used once decoding has already failed.
+// Types with their own unmarshaller are treated as leaves, so an empty path is returned if the failure is at the top level.
+func failedFieldPath(data []byte, t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return ""
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return ""
+		}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if !field.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			if raw, exists := fields[name]; exists && json.Unmarshal(raw, reflect.New(field.Type).Interface()) != nil {
+				return joinFieldPath(name, failedFieldPath(raw, field.Type))
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return ""
+		}
+		for i, item := range items {
+			if json.Unmarshal(item, reflect.New(t.Elem()).Interface()) != nil {
+				return joinFieldPath(fmt.Sprintf("[%d]", i), failedFieldPath(item, t.Elem()))
+			}
+		}
+
+	case reflect.Map:
+		var entries map[string]json.RawMessage
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return ""
+		}
+		for key, entry := range entries {
+			if json.Unmarshal(entry, reflect.New(t.Elem()).Interface()) != nil {
+				return joinFieldPath(key, failedFieldPath(entry, t.Elem()))
+			}
+		}
+	}
+	return ""
+}
+
+// Append a child to a JSON path, without a separator for array indices
+func joinFieldPath(parent string, child string) string {
+	switch {
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	default:
+		return parent + "." + child
+	}
+}
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+func TestUintegerUnmarshal(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected uint64
+		valid    bool
+	}{
+		{body: `{"epoch": "12345"}`, expected: 12345, valid: true},
+		{body: `{"epoch": 12345}`, expected: 12345, valid: true},
+		{body: `{"epoch": "18446744073709551615"}`, expected: 18446744073709551615, valid: true},
+		{body: `{"epoch": 0}`, expected: 0, valid: true},
+		{body: `{"epoch": "-1"}`},
+		{body: `{"epoch": -1}`},
+		{body: `{"epoch": "12.5"}`},
+		{body: `{"epoch": 1e3}`},
+		{body: `{"epoch": "abc"}`},
+		{body: `{"epoch": "18446744073709551616"}`},
+		{body: `{"epoch": true}`},
+	}
+	for _, test := range tests {
+		var value struct {
+			Epoch uinteger `json:"epoch"`
+		}
+		err := json.Unmarshal([]byte(test.body), &value)
+		if !test.valid {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %d", test.body, value.Epoch)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.body, err.Error())
+			continue
+		}
+		if uint64(value.Epoch) != test.expected {
+			t.Errorf("%s: expected %d, got %d", test.body, test.expected, value.Epoch)
+		}
+	}
+}
+
+// A finality checkpoints response as served by a mainnet node; each checkpoint has a distinct root so swapped fields are caught
+const finalityCheckpointsBody = `{
+  "execution_optimistic": false,
+  "finalized": false,
+  "data": {
+    "previous_justified": {
+      "epoch": "325633",
+      "root": "0x4d8f3c7a6d7b3fa0a6f8e0b7d5f6c2b1e9b1fa2c6a0e3e9dd0b4c7a1f2e3d4c5"
+    },
+    "current_justified": {
+      "epoch": "325634",
+      "root": "0x8a1e2c9f6b5d4e3a7c0b1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f60718293a4b5c6"
+    },
+    "finalized": {
+      "epoch": "325632",
+      "root": "0x2f67b1e04a3c9d8e5f6a7b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f7081920"
+    }
+  }
+}`
+
+func TestFinalityCheckpointsRoundTrip(t *testing.T) {
+	var checkpoints FinalityCheckpointsResponse
+	if err := json.Unmarshal([]byte(finalityCheckpointsBody), &checkpoints); err != nil {
+		t.Fatalf("error decoding finality checkpoints: %s", err.Error())
+	}
+
+	previousJustified := "0x4d8f3c7a6d7b3fa0a6f8e0b7d5f6c2b1e9b1fa2c6a0e3e9dd0b4c7a1f2e3d4c5"
+	currentJustified := "0x8a1e2c9f6b5d4e3a7c0b1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f60718293a4b5c6"
+	finalized := "0x2f67b1e04a3c9d8e5f6a7b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f7081920"
+	checkCheckpoint := func(name string, checkpoint Checkpoint, epoch uint64, root string) {
+		if uint64(checkpoint.Epoch) != epoch {
+			t.Errorf("%s: expected epoch %d, got %d", name, epoch, checkpoint.Epoch)
+		}
+		if encoded := "0x" + hex.EncodeToString(checkpoint.Root); encoded != root {
+			t.Errorf("%s: expected root %s, got %s", name, root, encoded)
+		}
+	}
+	checkCheckpoint("previous justified", checkpoints.Data.PreviousJustified, 325633, previousJustified)
+	checkCheckpoint("current justified", checkpoints.Data.CurrentJustified, 325634, currentJustified)
+	checkCheckpoint("finalized", checkpoints.Data.Finalized, 325632, finalized)
+
+	// Encoding and decoding again should give back the same checkpoints
+	encoded, err := json.Marshal(checkpoints)
+	if err != nil {
+		t.Fatalf("error encoding finality checkpoints: %s", err.Error())
+	}
+	var decoded FinalityCheckpointsResponse
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("error decoding re-encoded finality checkpoints: %s", err.Error())
+	}
+	checkCheckpoint("re-encoded previous justified", decoded.Data.PreviousJustified, 325633, previousJustified)
+	checkCheckpoint("re-encoded current justified", decoded.Data.CurrentJustified, 325634, currentJustified)
+	checkCheckpoint("re-encoded finalized", decoded.Data.Finalized, 325632, finalized)
+}
+
+func TestByteArrayUnmarshal(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected string
+		err      string
+	}{
+		{body: `"0x0a1B"`, expected: "0a1b"},
+		{body: `"0X0a1B"`, expected: "0a1b"},
+		{body: `"0a1b"`, expected: "0a1b"},
+		{body: `"0x"`, expected: ""},
+		{body: `"0x0a1"`, err: "invalid hex value '0x0a1': odd number of digits (3)"},
+		{body: `"0x0a1g"`, err: "invalid hex value '0x0a1g': non-hex character 'g'"},
+		{body: `12`, err: "cannot unmarshal"},
+	}
+	for _, test := range tests {
+		var value byteArray
+		err := json.Unmarshal([]byte(test.body), &value)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", test.body, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.body, err.Error())
+			continue
+		}
+		if encoded := hex.EncodeToString(value); encoded != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.body, test.expected, encoded)
+		}
+	}
+}
+
+func TestDecodeResponseFieldErrors(t *testing.T) {
+	credentials := "0x010000000000000000000000" + strings.Repeat("ab", 20)
+	pubkey := "0x" + strings.Repeat("cd", 48)
+	validator := func(pubkey string, credentials string) string {
+		return `{"index": "1", "balance": "32000000000", "status": "active_ongoing", "validator": {"pubkey": "` + pubkey + `", "withdrawal_credentials": "` + credentials + `"}}`
+	}
+	tests := []struct {
+		name   string
+		body   string
+		target interface{}
+		err    string
+	}{
+		{
+			name:   "valid validators",
+			body:   `{"data": [` + validator(pubkey, credentials) + `]}`,
+			target: &ValidatorsResponse{},
+		},
+		{
+			name:   "odd credentials",
+			body:   `{"data": [` + validator(pubkey, credentials) + `, ` + validator(pubkey, credentials[:len(credentials)-1]) + `]}`,
+			target: &ValidatorsResponse{},
+			err:    "data[1].validator.withdrawal_credentials: invalid hex value",
+		},
+		{
+			name:   "short pubkey",
+			body:   `{"data": ` + validator(pubkey[:len(pubkey)-2], credentials) + `}`,
+			target: &ValidatorResponse{},
+			err:    "data.validator.pubkey: invalid hex value '" + pubkey[:12] + "...",
+		},
+		{
+			name:   "non-hex genesis validators root",
+			body:   `{"data": {"genesis_time": "1606824023", "genesis_fork_version": "0x00000000", "genesis_validators_root": "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe9z"}}`,
+			target: &GenesisResponse{},
+			err:    "data.genesis_validators_root: invalid hex value",
+		},
+		{
+			name:   "bad root in a list",
+			body:   `{"data": {"finalized": ["0x00", "0x0"]}}`,
+			target: &DepositSnapshotResponse{},
+			err:    "data.finalized[1]: invalid hex value",
+		},
+	}
+	for _, test := range tests {
+		err := decodeResponse([]byte(test.body), test.target)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", test.name, err.Error())
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", test.name, test.err, err)
+		}
+	}
+}
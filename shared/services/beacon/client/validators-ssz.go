@@ -0,0 +1,246 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	ssz "github.com/ferranbt/fastssz"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// SSZ layout of the start of a BeaconState, up to the validators and balances.
+// The fields before them haven't changed since phase0, and the field after slashings is a variable-size list in every fork
+// (previous_epoch_attestations before Altair and previous_epoch_participation since), so its offset marks the end of the
+// balances no matter which fork the state is from. Only the vector lengths depend on the network's preset.
+const (
+	beaconStateSlotOffset  = 8 + 32                // After genesis_time and genesis_validators_root
+	beaconStateRootsOffset = 8 + 32 + 8 + 16 + 112 // After slot, fork, and latest_block_header too
+	beaconStateEth1Size    = 4 + 72 + 4 + 8        // historical_roots offset, eth1_data, eth1_data_votes offset, eth1_deposit_index
+	validatorSszSize       = 121
+	balanceSszSize         = 8
+)
+
+// Get every validator in a state, optionally filtered by status, from the SSZ encoding of the state.
+// Returns false if the node doesn't serve states as SSZ.
+func (c *StandardHttpClient) getValidatorsSsz(ctx context.Context, stateId string, statuses []ValidatorStatus) (ValidatorsResponse, bool, error) {
+	config, err := c.getEth2Config(ctx)
+	if err != nil {
+		return ValidatorsResponse{}, false, fmt.Errorf("Could not get validators: %w", err)
+	}
+
+	response, err := c.sendGetRequest(ctx, fmt.Sprintf(RequestDebugBeaconStatePath, stateId), RequestSszContentType)
+	if err != nil {
+		return ValidatorsResponse{}, false, fmt.Errorf("Could not get validators: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	// Some nodes ignore the Accept header and respond with JSON anyway, which is no faster than the validators route
+	if response.StatusCode == http.StatusNotAcceptable || (response.StatusCode == http.StatusOK && !strings.HasPrefix(response.Header.Get("Content-Type"), RequestSszContentType)) {
+		c.sszValidatorsUnsupported.Store(true)
+		return ValidatorsResponse{}, false, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		if err := stateUnavailableError(stateId, response.StatusCode, body); err != nil {
+			return ValidatorsResponse{}, false, fmt.Errorf("Could not get validators: %w", err)
+		}
+		return ValidatorsResponse{}, false, fmt.Errorf("Could not get validators: HTTP status %d; response body: '%s'", response.StatusCode, string(body))
+	}
+
+	var validators ValidatorsResponse
+	validators.Data = validatorsSlicePool.Get().([]Validator)
+	if err := validators.unmarshalBeaconStateSSZ(bufio.NewReader(response.Body), config, statuses); err != nil {
+		validators.Release()
+		return ValidatorsResponse{}, false, fmt.Errorf("Could not decode validators: %w", err)
+	}
+	return validators, true, nil
+}
+
+// Read the validators and balances from an SSZ-encoded BeaconState, deriving each validator's status from the state's epoch.
+// Reading stops once the balances have been read, so the rest of the state is never downloaded.
+func (r *ValidatorsResponse) unmarshalBeaconStateSSZ(reader io.Reader, config Eth2ConfigResponse, statuses []ValidatorStatus) error {
+	slotsPerEpoch := uint64(config.Data.SlotsPerEpoch)
+	slotsPerHistoricalRoot := uint64(config.Data.SlotsPerHistoricalRoot)
+	epochsPerHistoricalVector := uint64(config.Data.EpochsPerHistoricalVector)
+	epochsPerSlashingsVector := uint64(config.Data.EpochsPerSlashingsVector)
+	if slotsPerEpoch == 0 || slotsPerHistoricalRoot == 0 || epochsPerHistoricalVector == 0 || epochsPerSlashingsVector == 0 {
+		return fmt.Errorf("the node's spec is missing the preset values needed to decode its state")
+	}
+	state := &sszReader{reader: reader}
+
+	// Slot
+	header, err := state.read(beaconStateRootsOffset)
+	if err != nil {
+		return fmt.Errorf("error reading state header: %w", err)
+	}
+	epoch := ssz.UnmarshallUint64(header[beaconStateSlotOffset:]) / slotsPerEpoch
+
+	// Offsets of the validators, the balances, and the field after them
+	if err := state.skip(2 * slotsPerHistoricalRoot * 32); err != nil {
+		return fmt.Errorf("error reading state roots: %w", err)
+	}
+	eth1, err := state.read(beaconStateEth1Size + 8)
+	if err != nil {
+		return fmt.Errorf("error reading state offsets: %w", err)
+	}
+	validatorsOffset := ssz.ReadOffset(eth1[beaconStateEth1Size:])
+	balancesOffset := ssz.ReadOffset(eth1[beaconStateEth1Size+4:])
+	if err := state.skip(epochsPerHistoricalVector*32 + epochsPerSlashingsVector*8); err != nil {
+		return fmt.Errorf("error reading state randao mixes and slashings: %w", err)
+	}
+	end, err := state.read(4)
+	if err != nil {
+		return fmt.Errorf("error reading state offsets: %w", err)
+	}
+	balancesEnd := ssz.ReadOffset(end)
+
+	// Check the offsets before trusting them with allocations
+	validatorsSize := balancesOffset - validatorsOffset
+	balancesSize := balancesEnd - balancesOffset
+	if validatorsOffset < state.position || balancesOffset < validatorsOffset || balancesEnd < balancesOffset {
+		return fmt.Errorf("%w: invalid validators offsets %d, %d, and %d", ssz.ErrOffset, validatorsOffset, balancesOffset, balancesEnd)
+	}
+	if validatorsSize%validatorSszSize != 0 || balancesSize%balanceSszSize != 0 || validatorsSize/validatorSszSize != balancesSize/balanceSszSize {
+		return fmt.Errorf("%w: %d bytes of validators don't match %d bytes of balances", ssz.ErrSize, validatorsSize, balancesSize)
+	}
+	count := int(validatorsSize / validatorSszSize)
+
+	// Validators
+	if err := state.skip(validatorsOffset - state.position); err != nil {
+		return fmt.Errorf("error reading state: %w", err)
+	}
+	if count > cap(r.Data) {
+		validatorsSlicePool.Put(r.Data)
+		r.Data = make([]Validator, 0, count)
+	}
+	r.Data = r.Data[:count]
+	for i := range r.Data {
+		buf, err := state.read(validatorSszSize)
+		if err != nil {
+			return fmt.Errorf("error reading validator %d: %w", i, err)
+		}
+		if err := r.Data[i].unmarshalSSZ(buf); err != nil {
+			return fmt.Errorf("error unmarshalling validator %d: %w", i, err)
+		}
+		r.Data[i].Index = strconv.Itoa(i)
+	}
+
+	// Balances, and the statuses that depend on them; filtered out validators are dropped in place
+	kept := 0
+	for i := range r.Data {
+		buf, err := state.read(balanceSszSize)
+		if err != nil {
+			return fmt.Errorf("error reading balance %d: %w", i, err)
+		}
+		validator := r.Data[i]
+		validator.Balance = uinteger(ssz.UnmarshallUint64(buf))
+		validator.Status = validator.statusAt(epoch)
+		if matchesStatus(validator.Status, statuses) {
+			r.Data[kept] = validator
+			kept++
+		}
+	}
+	for i := kept; i < count; i++ {
+		r.Data[i] = Validator{}
+	}
+	r.Data = r.Data[:kept]
+	return nil
+}
+
+// Unmarshal a phase0 Validator container; the pubkey and withdrawal credentials share one allocation
+func (v *Validator) unmarshalSSZ(buf []byte) error {
+	keys := make([]byte, 80)
+	copy(keys, buf[0:80])
+	v.Validator.Pubkey = keys[0:48:48]
+	v.Validator.WithdrawalCredentials = keys[48:80:80]
+	v.Validator.EffectiveBalance = uinteger(ssz.UnmarshallUint64(buf[80:88]))
+	switch buf[88] {
+	case 0:
+		v.Validator.Slashed = false
+	case 1:
+		v.Validator.Slashed = true
+	default:
+		return fmt.Errorf("invalid slashed flag %d", buf[88])
+	}
+	v.Validator.ActivationEligibilityEpoch = uinteger(ssz.UnmarshallUint64(buf[89:97]))
+	v.Validator.ActivationEpoch = uinteger(ssz.UnmarshallUint64(buf[97:105]))
+	v.Validator.ExitEpoch = uinteger(ssz.UnmarshallUint64(buf[105:113]))
+	v.Validator.WithdrawableEpoch = uinteger(ssz.UnmarshallUint64(buf[113:121]))
+	return nil
+}
+
+// Get a validator's status at an epoch, the same way the Beacon API derives it for the validators route
+func (v *Validator) statusAt(epoch uint64) ValidatorStatus {
+	switch {
+	case uint64(v.Validator.ActivationEpoch) > epoch:
+		if uint64(v.Validator.ActivationEligibilityEpoch) == beacon.FarFutureEpoch {
+			return ValidatorStatus_PendingInitialized
+		}
+		return ValidatorStatus_PendingQueued
+	case uint64(v.Validator.ExitEpoch) > epoch:
+		if uint64(v.Validator.ExitEpoch) == beacon.FarFutureEpoch {
+			return ValidatorStatus_ActiveOngoing
+		}
+		if v.Validator.Slashed {
+			return ValidatorStatus_ActiveSlashed
+		}
+		return ValidatorStatus_ActiveExiting
+	case uint64(v.Validator.WithdrawableEpoch) > epoch:
+		if v.Validator.Slashed {
+			return ValidatorStatus_ExitedSlashed
+		}
+		return ValidatorStatus_ExitedUnslashed
+	case v.Balance != 0:
+		return ValidatorStatus_WithdrawalPossible
+	default:
+		return ValidatorStatus_WithdrawalDone
+	}
+}
+
+// Check if a status passes a status filter, which can also use the API's general statuses like "active"
+func matchesStatus(status ValidatorStatus, statuses []ValidatorStatus) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, filter := range statuses {
+		if status == filter || strings.HasPrefix(string(status), string(filter)+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// Sequential reader for an SSZ stream that keeps track of its position
+type sszReader struct {
+	reader   io.Reader
+	buf      []byte
+	position uint64
+}
+
+// Read the next size bytes; the returned slice is only valid until the next read
+func (r *sszReader) read(size int) ([]byte, error) {
+	if cap(r.buf) < size {
+		r.buf = make([]byte, size)
+	}
+	buf := r.buf[:size]
+	if _, err := io.ReadFull(r.reader, buf); err != nil {
+		return nil, err
+	}
+	r.position += uint64(size)
+	return buf, nil
+}
+
+// Skip the next size bytes
+func (r *sszReader) skip(size uint64) error {
+	skipped, err := io.CopyN(io.Discard, r.reader, int64(size))
+	r.position += uint64(skipped)
+	return err
+}
@@ -0,0 +1,208 @@
+package client_test
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+const farFutureEpoch = beacon.FarFutureEpoch
+
+// Validators covering every status at epoch 100, in the order of the statuses they should get
+var sszStateValidators = []clienttest.Validator{
+	{Balance: 32e9, ActivationEligibilityEpoch: farFutureEpoch, ActivationEpoch: farFutureEpoch, ExitEpoch: farFutureEpoch, WithdrawableEpoch: farFutureEpoch},
+	{Balance: 32e9, ActivationEligibilityEpoch: 90, ActivationEpoch: farFutureEpoch, ExitEpoch: farFutureEpoch, WithdrawableEpoch: farFutureEpoch},
+	{Balance: 32e9, ActivationEligibilityEpoch: 5, ActivationEpoch: 10, ExitEpoch: farFutureEpoch, WithdrawableEpoch: farFutureEpoch},
+	{Balance: 32e9, ActivationEligibilityEpoch: 5, ActivationEpoch: 10, ExitEpoch: 200, WithdrawableEpoch: 456},
+	{Balance: 31e9, Slashed: true, ActivationEligibilityEpoch: 5, ActivationEpoch: 10, ExitEpoch: 200, WithdrawableEpoch: 8392},
+	{Balance: 32e9, ActivationEligibilityEpoch: 5, ActivationEpoch: 10, ExitEpoch: 50, WithdrawableEpoch: 150},
+	{Balance: 31e9, Slashed: true, ActivationEligibilityEpoch: 5, ActivationEpoch: 10, ExitEpoch: 50, WithdrawableEpoch: 150},
+	{Balance: 32e9, ActivationEligibilityEpoch: 5, ActivationEpoch: 10, ExitEpoch: 50, WithdrawableEpoch: 60},
+	{Balance: 0, ActivationEligibilityEpoch: 5, ActivationEpoch: 10, ExitEpoch: 50, WithdrawableEpoch: 60},
+}
+var sszStateStatuses = []beacon.ValidatorState{
+	beacon.ValidatorState_PendingInitialized,
+	beacon.ValidatorState_PendingQueued,
+	beacon.ValidatorState_ActiveOngoing,
+	beacon.ValidatorState_ActiveExiting,
+	beacon.ValidatorState_ActiveSlashed,
+	beacon.ValidatorState_ExitedUnslashed,
+	beacon.ValidatorState_ExitedSlashed,
+	beacon.ValidatorState_WithdrawalPossible,
+	beacon.ValidatorState_WithdrawalDone,
+}
+
+// Encode the start of an Altair-or-later BeaconState with the mainnet preset, up to and including the balances.
+// The fields the client doesn't read are filled with junk so reading the wrong bytes would show up.
+func encodeBeaconState(slot uint64, validators []clienttest.Validator) []byte {
+	junk := func(size int) []byte {
+		buf := make([]byte, size)
+		for i := range buf {
+			buf[i] = byte(i*7 + 3)
+		}
+		return buf
+	}
+	uint64Bytes := func(value uint64) []byte {
+		return binary.LittleEndian.AppendUint64(nil, value)
+	}
+	offsetBytes := func(value int) []byte {
+		return binary.LittleEndian.AppendUint32(nil, uint32(value))
+	}
+
+	// Variable-size data: historical roots and eth1 data votes, then the validators and balances
+	historicalRoots := junk(2 * 32)
+	eth1DataVotes := junk(72)
+	var encodedValidators, balances []byte
+	for i, validator := range validators {
+		pubkey := validator.Pubkey
+		pubkey[0] = byte(i + 1)
+		credentials := validator.WithdrawalCredentials
+		credentials[31] = byte(i + 1)
+		encodedValidators = append(encodedValidators, pubkey[:]...)
+		encodedValidators = append(encodedValidators, credentials[:]...)
+		encodedValidators = append(encodedValidators, uint64Bytes(validator.EffectiveBalance)...)
+		if validator.Slashed {
+			encodedValidators = append(encodedValidators, 1)
+		} else {
+			encodedValidators = append(encodedValidators, 0)
+		}
+		encodedValidators = append(encodedValidators, uint64Bytes(validator.ActivationEligibilityEpoch)...)
+		encodedValidators = append(encodedValidators, uint64Bytes(validator.ActivationEpoch)...)
+		encodedValidators = append(encodedValidators, uint64Bytes(validator.ExitEpoch)...)
+		encodedValidators = append(encodedValidators, uint64Bytes(validator.WithdrawableEpoch)...)
+		balances = append(balances, uint64Bytes(validator.Balance)...)
+	}
+
+	// The fixed part, with the rest of it after the participation offset stood in for by junk
+	fixedSize := 8 + 32 + 8 + 16 + 112 + 2*8192*32 + 4 + 72 + 4 + 8 + 4 + 4 + 65536*32 + 8192*8 + 4 + 1000
+	historicalRootsOffset := fixedSize
+	eth1DataVotesOffset := historicalRootsOffset + len(historicalRoots)
+	validatorsOffset := eth1DataVotesOffset + len(eth1DataVotes)
+	balancesOffset := validatorsOffset + len(encodedValidators)
+	participationOffset := balancesOffset + len(balances)
+
+	state := junk(8 + 32)
+	state = append(state, uint64Bytes(slot)...)
+	state = append(state, junk(16+112+2*8192*32)...)
+	state = append(state, offsetBytes(historicalRootsOffset)...)
+	state = append(state, junk(72)...)
+	state = append(state, offsetBytes(eth1DataVotesOffset)...)
+	state = append(state, junk(8)...)
+	state = append(state, offsetBytes(validatorsOffset)...)
+	state = append(state, offsetBytes(balancesOffset)...)
+	state = append(state, junk(65536*32+8192*8)...)
+	state = append(state, offsetBytes(participationOffset)...)
+	state = append(state, junk(1000)...)
+	state = append(state, historicalRoots...)
+	state = append(state, eth1DataVotes...)
+	state = append(state, encodedValidators...)
+	state = append(state, balances...)
+	return append(state, junk(len(validators))...)
+}
+
+func newSszStateServer() *clienttest.Server {
+	server := clienttest.NewServer()
+	server.SetResponse(fmt.Sprintf(client.RequestDebugBeaconStatePath, "head"), clienttest.Response{
+		Status: http.StatusOK,
+		Header: http.Header{"Content-Type": []string{client.RequestSszContentType}},
+		Body:   encodeBeaconState(100*32+5, sszStateValidators),
+	})
+	return server
+}
+
+func TestGetValidatorsSsz(t *testing.T) {
+	server := newSszStateServer()
+	defer server.Close()
+	bc := client.NewStandardHttpClient(server.URL, client.WithSszValidators())
+
+	validators, err := bc.GetValidatorsByStatus(context.Background(), "head", nil)
+	if err != nil {
+		t.Fatalf("error getting validators: %s", err.Error())
+	}
+	if len(validators) != len(sszStateValidators) {
+		t.Fatalf("expected %d validators, got %d", len(sszStateValidators), len(validators))
+	}
+	for i, validator := range validators {
+		expected := sszStateValidators[i]
+		if validator.Index != fmt.Sprint(i) || validator.Status != sszStateStatuses[i] || validator.Balance != expected.Balance || validator.Slashed != expected.Slashed {
+			t.Errorf("validator %d: expected index %d, status %s, balance %d, slashed %t; got index %s, status %s, balance %d, slashed %t", i, i, sszStateStatuses[i], expected.Balance, expected.Slashed, validator.Index, validator.Status, validator.Balance, validator.Slashed)
+		}
+		if validator.ActivationEligibilityEpoch != expected.ActivationEligibilityEpoch || validator.ActivationEpoch != expected.ActivationEpoch || validator.ExitEpoch != expected.ExitEpoch || validator.WithdrawableEpoch != expected.WithdrawableEpoch {
+			t.Errorf("validator %d: epochs don't match", i)
+		}
+		if validator.Pubkey[0] != byte(i+1) || validator.WithdrawalCredentials[31] != byte(i+1) {
+			t.Errorf("validator %d: expected its own pubkey and withdrawal credentials, got %s and %s", i, validator.Pubkey.Hex(), validator.WithdrawalCredentials.Hex())
+		}
+	}
+	if count := server.RequestCount(fmt.Sprintf(client.RequestValidatorsPath, "head")); count != 0 {
+		t.Errorf("expected the validators route not to be used, got %d requests", count)
+	}
+
+	// Filters can use specific statuses or general ones
+	filtered, err := bc.GetValidatorsByStatus(context.Background(), "head", []beacon.ValidatorState{beacon.ValidatorState_PendingQueued, "active"})
+	if err != nil {
+		t.Fatalf("error getting filtered validators: %s", err.Error())
+	}
+	var indices []string
+	for _, validator := range filtered {
+		indices = append(indices, validator.Index)
+	}
+	if fmt.Sprint(indices) != "[1 2 3 4]" {
+		t.Errorf("expected validators [1 2 3 4], got %v", indices)
+	}
+}
+
+func TestGetValidatorsSszFallback(t *testing.T) {
+	tests := []struct {
+		name     string
+		response clienttest.Response
+	}{
+		{name: "406", response: clienttest.Response{Status: http.StatusNotAcceptable, Body: []byte(`{"code":406,"message":"Not Acceptable"}`)}},
+		{name: "JSON", response: clienttest.Response{Status: http.StatusOK, Body: []byte(`{"version":"deneb","data":{}}`)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := clienttest.NewServer()
+			defer server.Close()
+			server.SetResponse(fmt.Sprintf(client.RequestDebugBeaconStatePath, "head"), test.response)
+			server.SetValidators("head", []clienttest.Validator{{Index: 0, Status: "active_ongoing"}, {Index: 1, Status: "active_ongoing"}})
+			bc := client.NewStandardHttpClient(server.URL, client.WithSszValidators())
+
+			// The node's lack of support is remembered, so the state is only requested once
+			for i := 0; i < 2; i++ {
+				validators, err := bc.GetValidatorsByStatus(context.Background(), "head", nil)
+				if err != nil {
+					t.Fatalf("error getting validators: %s", err.Error())
+				}
+				if len(validators) != 2 {
+					t.Fatalf("expected 2 validators from the validators route, got %d", len(validators))
+				}
+			}
+			if count := server.RequestCount(fmt.Sprintf(client.RequestDebugBeaconStatePath, "head")); count != 1 {
+				t.Errorf("expected 1 state request, got %d", count)
+			}
+		})
+	}
+}
+
+func TestGetValidatorsSszTruncated(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	state := encodeBeaconState(100*32, sszStateValidators)
+	server.SetResponse(fmt.Sprintf(client.RequestDebugBeaconStatePath, "head"), clienttest.Response{
+		Status: http.StatusOK,
+		Header: http.Header{"Content-Type": []string{client.RequestSszContentType}},
+		Body:   state[:len(state)-len(sszStateValidators)-4],
+	})
+	bc := client.NewStandardHttpClient(server.URL, client.WithSszValidators())
+
+	if _, err := bc.GetValidatorsByStatus(context.Background(), "head", nil); err == nil {
+		t.Fatal("expected an error for a truncated state")
+	}
+}
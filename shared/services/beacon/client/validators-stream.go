@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+// StreamValidators gets the validators for a state, optionally filtered by status, and invokes callback for
+// each one as it's decoded from the response. Unlike GetValidators, the full response body is never buffered,
+// so peak memory stays bounded even for the whole validator set.
+// The Validator passed to callback is reused between calls, so it must be copied if it needs to be retained.
+// Returning an error from callback stops the stream and returns that error.
+func (c *StandardHttpClient) StreamValidators(ctx context.Context, stateId string, statuses []ValidatorStatus, callback func(*Validator) error) error {
+	ctx, cancel := c.methodContext(ctx, "StreamValidators")
+	defer cancel()
+
+	return c.streamValidators(ctx, stateId, statuses, callback)
+}
+
+// GetValidatorsStreamed gets the validators for a state like StreamValidators does, collecting them into a
+// pooled slice that's preallocated to hold at least countHint validators. This avoids buffering the raw
+// response body alongside the decoded validators. Release must be called on the response when done with it.
+func (c *StandardHttpClient) GetValidatorsStreamed(ctx context.Context, stateId string, statuses []ValidatorStatus, countHint int) (ValidatorsResponse, error) {
+	ctx, cancel := c.methodContext(ctx, "GetValidatorsStreamed")
+	defer cancel()
+
+	var response ValidatorsResponse
+	response.Data = validatorsSlicePool.Get().([]Validator)
+	if countHint > cap(response.Data) {
+		// Put the smaller buffer back for someone else; the larger one joins the pool when this response is released
+		validatorsSlicePool.Put(response.Data)
+		response.Data = make([]Validator, 0, countHint)
+	}
+
+	err := c.streamValidators(ctx, stateId, statuses, func(validator *Validator) error {
+		response.Data = append(response.Data, *validator)
+		return nil
+	})
+	if err != nil {
+		response.Release()
+		return ValidatorsResponse{}, err
+	}
+	return response, nil
+}
+
+// Get the validators for a state, decoding the data array one element at a time
+func (c *StandardHttpClient) streamValidators(ctx context.Context, stateId string, statuses []ValidatorStatus, callback func(*Validator) error) error {
+
+	// Statuses are repeated since some nodes don't accept a list for them
+	queryParams := []string{}
+	for _, status := range statuses {
+		queryParams = append(queryParams, "status="+url.QueryEscape(string(status)))
+	}
+	requestPath := fmt.Sprintf(RequestValidatorsPath, stateId)
+	if len(queryParams) > 0 {
+		requestPath += "?" + strings.Join(queryParams, "&")
+	}
+
+	reader, status, err := c.getRequestReader(ctx, requestPath)
+	if err != nil {
+		return fmt.Errorf("Could not get validators: %w", err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	if status != http.StatusOK {
+		body, _ := io.ReadAll(reader)
+		if err := stateUnavailableError(stateId, status, body); err != nil {
+			return fmt.Errorf("Could not get validators: %w", err)
+		}
+		return fmt.Errorf("Could not get validators: HTTP status %d; response body: '%s'", status, string(body))
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return fmt.Errorf("Could not decode validators: %w", err)
+	}
+
+	// Walk the top-level object, skipping everything except the data array
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return fmt.Errorf("Could not decode validators: %w", err)
+		}
+		key, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("Could not decode validators: unexpected token %v", token)
+		}
+		if key != "data" {
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return fmt.Errorf("Could not decode validators: %w", err)
+			}
+			continue
+		}
+
+		if err := expectDelim(decoder, '['); err != nil {
+			return fmt.Errorf("Could not decode validators: %w", err)
+		}
+		// Each validator is read raw first so a decoding error can name the field that caused it
+		var raw json.RawMessage
+		var validator Validator
+		for i := 0; decoder.More(); i++ {
+			if err := decoder.Decode(&raw); err != nil {
+				return fmt.Errorf("Could not decode validators: %w", err)
+			}
+			validator = Validator{}
+			if err := decodeResponse(raw, &validator); err != nil {
+				return fmt.Errorf("Could not decode validator %d: %w", i, err)
+			}
+			if err := callback(&validator); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return fmt.Errorf("Could not decode validators: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Read the next token from the decoder and make sure it's the given delimiter
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected '%s' but got %v", delim, token)
+	}
+	return nil
+}
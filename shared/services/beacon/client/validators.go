@@ -0,0 +1,286 @@
+package client
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Validator status, as defined by the Beacon API spec
+type ValidatorStatus string
+
+const (
+	ValidatorStatus_PendingInitialized ValidatorStatus = "pending_initialized"
+	ValidatorStatus_PendingQueued      ValidatorStatus = "pending_queued"
+	ValidatorStatus_ActiveOngoing      ValidatorStatus = "active_ongoing"
+	ValidatorStatus_ActiveExiting      ValidatorStatus = "active_exiting"
+	ValidatorStatus_ActiveSlashed      ValidatorStatus = "active_slashed"
+	ValidatorStatus_ExitedUnslashed    ValidatorStatus = "exited_unslashed"
+	ValidatorStatus_ExitedSlashed      ValidatorStatus = "exited_slashed"
+	ValidatorStatus_WithdrawalPossible ValidatorStatus = "withdrawal_possible"
+	ValidatorStatus_WithdrawalDone     ValidatorStatus = "withdrawal_done"
+)
+
+// Unmarshal a validator status, rejecting any status that isn't part of the spec
+func (s *ValidatorStatus) UnmarshalJSON(data []byte) error {
+
+	// Unmarshal string
+	var dataStr string
+	if err := json.Unmarshal(data, &dataStr); err != nil {
+		return err
+	}
+
+	// Check the status
+	status := ValidatorStatus(dataStr)
+	switch status {
+	case ValidatorStatus_PendingInitialized,
+		ValidatorStatus_PendingQueued,
+		ValidatorStatus_ActiveOngoing,
+		ValidatorStatus_ActiveExiting,
+		ValidatorStatus_ActiveSlashed,
+		ValidatorStatus_ExitedUnslashed,
+		ValidatorStatus_ExitedSlashed,
+		ValidatorStatus_WithdrawalPossible,
+		ValidatorStatus_WithdrawalDone:
+	default:
+		return fmt.Errorf("unknown validator status '%s'", dataStr)
+	}
+
+	// Set value and return
+	*s = status
+	return nil
+
+}
+
+// True if the validator is active (including validators that are exiting or slashed but haven't exited yet)
+func (s ValidatorStatus) IsActive() bool {
+	switch s {
+	case ValidatorStatus_ActiveOngoing, ValidatorStatus_ActiveExiting, ValidatorStatus_ActiveSlashed:
+		return true
+	}
+	return false
+}
+
+// True if the validator has exited, including validators that are withdrawable or fully withdrawn
+func (s ValidatorStatus) IsExited() bool {
+	switch s {
+	case ValidatorStatus_ExitedUnslashed, ValidatorStatus_ExitedSlashed, ValidatorStatus_WithdrawalPossible, ValidatorStatus_WithdrawalDone:
+		return true
+	}
+	return false
+}
+
+// True if the status indicates the validator was slashed.
+// Note that the withdrawal statuses don't carry slashing information; use Validator.Validator.Slashed for those.
+func (s ValidatorStatus) IsSlashed() bool {
+	switch s {
+	case ValidatorStatus_ActiveSlashed, ValidatorStatus_ExitedSlashed:
+		return true
+	}
+	return false
+}
+
+// Withdrawal credential type, as given by the first byte of a validator's withdrawal credentials
+type CredentialType byte
+
+const (
+	CredentialType_BLS         CredentialType = 0x00
+	CredentialType_Execution   CredentialType = 0x01
+	CredentialType_Compounding CredentialType = 0x02 // Added in Electra; these also withdraw to an execution address
+)
+
+// Get the type of the validator's withdrawal credentials.
+// Validators with BLS credentials need a BLS to execution change before they can withdraw.
+func (v *Validator) CredentialType() CredentialType {
+	if len(v.Validator.WithdrawalCredentials) == 0 {
+		return CredentialType_BLS
+	}
+	return CredentialType(v.Validator.WithdrawalCredentials[0])
+}
+
+// Get the execution address the validator withdraws to, or false if it still has BLS credentials
+func (v *Validator) WithdrawalAddress() (common.Address, bool) {
+	switch v.CredentialType() {
+	case CredentialType_Execution, CredentialType_Compounding:
+		return common.BytesToAddress(v.Validator.WithdrawalCredentials[12:]), true
+	}
+	return common.Address{}, false
+}
+
+// Get the validator's balance as a big.Int, in gwei
+func (v *Validator) BalanceGwei() *big.Int {
+	return new(big.Int).SetUint64(uint64(v.Balance))
+}
+
+// Get the validator's effective balance as a big.Int, in gwei
+func (v *Validator) EffectiveBalanceGwei() *big.Int {
+	return new(big.Int).SetUint64(uint64(v.Validator.EffectiveBalance))
+}
+
+// Get the sum of all of the validators' balances, in gwei.
+// The total is accumulated as a big.Int since it can overflow a uint64 across the whole validator set.
+func (r *ValidatorsResponse) TotalBalance() *big.Int {
+	total := big.NewInt(0)
+	balance := new(big.Int)
+	for _, validator := range r.Data {
+		balance.SetUint64(uint64(validator.Balance))
+		total.Add(total, balance)
+	}
+	return total
+}
+
+// Get the validators that still have BLS withdrawal credentials, and so need a BLS to execution change before they can withdraw.
+// The validators are copied out of Data, so the result can be kept after the response is released.
+func (r *ValidatorsResponse) PendingCredentialMigration() []Validator {
+	pending := []Validator{}
+	for _, validator := range r.Data {
+		if validator.CredentialType() == CredentialType_BLS {
+			pending = append(pending, validator)
+		}
+	}
+	return pending
+}
+
+// Build the lookup index used by ByIndex and ByPubkey, keyed by both validator index and 0x-prefixed pubkey.
+// The index is rebuilt automatically if Data has been replaced since it was last built.
+func (r *ValidatorsResponse) BuildIndex() {
+	if r.isIndexed() {
+		return
+	}
+	r.index = make(map[string]*Validator, len(r.Data)*2)
+	for i := range r.Data {
+		validator := &r.Data[i]
+		r.index[validator.Index] = validator
+		r.index[hexutil.AddPrefix(hex.EncodeToString(validator.Validator.Pubkey))] = validator
+	}
+	r.indexedData = r.Data
+}
+
+// Get the validator with the provided index, or nil if it isn't in the response
+func (r *ValidatorsResponse) ByIndex(idx string) *Validator {
+	r.BuildIndex()
+	return r.index[idx]
+}
+
+// Get the validator with the provided pubkey, or nil if it isn't in the response
+func (r *ValidatorsResponse) ByPubkey(pubkey []byte) *Validator {
+	r.BuildIndex()
+	return r.index[hexutil.AddPrefix(hex.EncodeToString(pubkey))]
+}
+
+// Check if the lookup index was built over the current Data slice
+func (r *ValidatorsResponse) isIndexed() bool {
+	if r.index == nil || len(r.Data) != len(r.indexedData) {
+		return false
+	}
+	return len(r.Data) == 0 || &r.Data[0] == &r.indexedData[0]
+}
+
+// The changes to a validator set between two snapshots
+type ValidatorsDiff struct {
+	Added                   []string // Validators in the new snapshot that weren't in the old one
+	NewlySlashed            []string
+	NewlyExited             []string
+	EffectiveBalanceChanges []EffectiveBalanceChange
+}
+type EffectiveBalanceChange struct {
+	Index      string
+	OldBalance uint64
+	NewBalance uint64
+}
+
+// Compare two snapshots of a validator set, matching validators by index.
+// Validators that only appear in the new snapshot are reported as added, and aren't reported as slashed or exited.
+func DiffValidators(old *ValidatorsResponse, new *ValidatorsResponse) ValidatorsDiff {
+	diff := ValidatorsDiff{}
+	for i := range new.Data {
+		current := &new.Data[i]
+		previous := old.ByIndex(current.Index)
+		if previous == nil {
+			diff.Added = append(diff.Added, current.Index)
+			continue
+		}
+
+		if current.Validator.Slashed && !previous.Validator.Slashed {
+			diff.NewlySlashed = append(diff.NewlySlashed, current.Index)
+		}
+		if current.Status.IsExited() && !previous.Status.IsExited() {
+			diff.NewlyExited = append(diff.NewlyExited, current.Index)
+		}
+		if current.Validator.EffectiveBalance != previous.Validator.EffectiveBalance {
+			diff.EffectiveBalanceChanges = append(diff.EffectiveBalanceChanges, EffectiveBalanceChange{
+				Index:      current.Index,
+				OldBalance: uint64(previous.Validator.EffectiveBalance),
+				NewBalance: uint64(current.Validator.EffectiveBalance),
+			})
+		}
+	}
+	return diff
+}
+
+// Custom deserialization logic for ValidatorsResponse allows us to pool the
+// validator slices for reuse. Responses for the full validator set are very
+// large, so this cuts down on allocations substantially when polling.
+var validatorsSlicePool sync.Pool = sync.Pool{
+	New: func() any {
+		return make([]Validator, 0, 1024)
+	},
+}
+
+// Alias that doesn't carry the custom unmarshaller, so the default one can be used on it
+type validatorsResponseRaw ValidatorsResponse
+
+func (r *ValidatorsResponse) UnmarshalJSON(body []byte) error {
+	// Since r.Data is preallocated, this will re-use a buffer if one was available.
+	r.Data = validatorsSlicePool.Get().([]Validator)
+	if err := decodeResponse(body, (*validatorsResponseRaw)(r)); err != nil {
+		return fmt.Errorf("error unmarshalling validators json: %w", err)
+	}
+	return nil
+}
+
+// Release returns the reused validator slice buffer to the pool for further
+// reuse. It must be called when the user is done with this response; the
+// Data slice (and any subslices of it) must not be retained afterwards.
+func (r *ValidatorsResponse) Release() {
+	if r.Data == nil {
+		return
+	}
+
+	// Reset each validator so stale data can't leak into the next decode
+	for i := range r.Data {
+		r.Data[i] = Validator{}
+	}
+
+	// Reset the slice length to 0 (capacity stays the same) and return it for reuse
+	validatorsSlicePool.Put(r.Data[:0])
+	r.Data = nil
+	r.index = nil
+	r.indexedData = nil
+}
+
+// Convert the validator to its status
+func (v *Validator) toBeacon() beacon.ValidatorStatus {
+	return beacon.ValidatorStatus{
+		Pubkey:                     types.BytesToValidatorPubkey(v.Validator.Pubkey),
+		Index:                      v.Index,
+		WithdrawalCredentials:      common.BytesToHash(v.Validator.WithdrawalCredentials),
+		Balance:                    uint64(v.Balance),
+		EffectiveBalance:           uint64(v.Validator.EffectiveBalance),
+		Status:                     beacon.ValidatorState(v.Status),
+		Slashed:                    v.Validator.Slashed,
+		ActivationEligibilityEpoch: uint64(v.Validator.ActivationEligibilityEpoch),
+		ActivationEpoch:            uint64(v.Validator.ActivationEpoch),
+		ExitEpoch:                  uint64(v.Validator.ExitEpoch),
+		WithdrawableEpoch:          uint64(v.Validator.WithdrawableEpoch),
+		Exists:                     true,
+	}
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// Known implementation names, keyed by the lowercase product token at the start of the version string
+var knownImplementations = map[string]string{
+	"lighthouse": beacon.Implementation_Lighthouse,
+	"prysm":      beacon.Implementation_Prysm,
+	"teku":       beacon.Implementation_Teku,
+	"nimbus":     beacon.Implementation_Nimbus,
+	"lodestar":   beacon.Implementation_Lodestar,
+	"grandine":   beacon.Implementation_Grandine,
+}
+
+// Parse a node version string such as "Lighthouse/v4.5.0-441fc16/x86_64-linux".
+// Unrecognized clients are reported as unknown rather than treated as an error.
+func parseNodeVersion(raw string) beacon.NodeVersion {
+	version := beacon.NodeVersion{
+		Raw:            raw,
+		Implementation: beacon.Implementation_Unknown,
+	}
+
+	parts := strings.Split(strings.TrimSpace(raw), "/")
+	implementation, exists := knownImplementations[strings.ToLower(parts[0])]
+	if !exists {
+		return version
+	}
+	version.Implementation = implementation
+	if len(parts) > 1 {
+		version.Version = strings.TrimPrefix(parts[1], "v")
+	}
+	return version
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// The number of consecutive polls the sync distance can grow for while syncing before the node is considered to be falling behind
+const fallingBehindPolls int = 3
+
+// Wait until the node's head reaches the provided slot, polling its sync status at the provided interval.
+// Returns the context's error if it's cancelled first, or an error wrapping beacon.ErrNodeFallingBehind
+// if the node is syncing and its sync distance keeps growing instead of catching up.
+func (c *StandardHttpClient) WaitForSlot(ctx context.Context, slot uint64, pollInterval time.Duration) error {
+	ctx, cancel := c.methodContext(ctx, "WaitForSlot")
+	defer cancel()
+	return c.waitForSlot(ctx, slot, pollInterval)
+}
+
+// Wait until the node's head reaches the first slot of the provided epoch, polling its sync status at the provided interval.
+// This behaves the same way as WaitForSlot.
+func (c *StandardHttpClient) WaitForEpoch(ctx context.Context, epoch uint64, pollInterval time.Duration) error {
+	ctx, cancel := c.methodContext(ctx, "WaitForEpoch")
+	defer cancel()
+	eth2Config, err := c.getEth2Config(ctx)
+	if err != nil {
+		return err
+	}
+	return c.waitForSlot(ctx, epoch*uint64(eth2Config.Data.SlotsPerEpoch), pollInterval)
+}
+
+// Poll the sync status until the head reaches the provided slot
+func (c *StandardHttpClient) waitForSlot(ctx context.Context, slot uint64, pollInterval time.Duration) error {
+	if pollInterval <= 0 {
+		return fmt.Errorf("Could not wait for slot %d: poll interval must be positive", slot)
+	}
+
+	var lastDistance uint64
+	growingPolls := 0
+	for {
+		syncStatus, err := c.getSyncStatus(ctx)
+		if err != nil {
+			return err
+		}
+		if uint64(syncStatus.Data.HeadSlot) >= slot {
+			return nil
+		}
+
+		// Check if the node is falling behind
+		distance := uint64(syncStatus.Data.SyncDistance)
+		if syncStatus.Data.IsSyncing && lastDistance > 0 && distance > lastDistance {
+			growingPolls++
+			if growingPolls >= fallingBehindPolls {
+				return fmt.Errorf("Could not wait for slot %d: head is at slot %d and the sync distance grew to %d: %w", slot, syncStatus.Data.HeadSlot, distance, beacon.ErrNodeFallingBehind)
+			}
+		} else {
+			growingPolls = 0
+		}
+		lastDistance = distance
+
+		// Wait for the next poll
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("Could not wait for slot %d: %w", slot, ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client/clienttest"
+)
+
+// Only 400s that say the state is too far ahead of the head are reported as such; invalid or pre-Capella states are plain errors
+func TestGetExpectedWithdrawalsStateTooFarInFuture(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		tooFar  bool
+	}{
+		{name: "Lighthouse too far", message: "BAD_REQUEST: request slot 5000 is too far in the future", tooFar: true},
+		{name: "Teku too far", message: "Requested state is too far in the future", tooFar: true},
+		{name: "invalid state ID", message: "BAD_REQUEST: invalid state ID: foo", tooFar: false},
+		{name: "pre-Capella state", message: "BAD_REQUEST: expected withdrawals are not available before Capella", tooFar: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := clienttest.NewServer()
+			defer server.Close()
+			server.SetError(fmt.Sprintf(client.RequestExpectedWithdrawalsPath, "5000"), http.StatusBadRequest, test.message, 0)
+
+			bc := client.NewStandardHttpClient(server.URL)
+			_, err := bc.GetExpectedWithdrawals(context.Background(), "5000")
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if errors.Is(err, beacon.ErrStateTooFarInFuture) != test.tooFar {
+				t.Errorf("expected errors.Is(err, ErrStateTooFarInFuture) to be %t, got error: %s", test.tooFar, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package beacon
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Errors returned by Beacon clients for conditions that callers may want to handle explicitly
+var (
+	// The requested state is too far ahead of the node's head state for it to be computed
+	ErrStateTooFarInFuture = errors.New("the requested state is too far in the future")
+
+	// The node doesn't have the requested state, usually because it's a historical state that a non-archive node has pruned
+	ErrStateUnavailable = errors.New("the requested state is not available on the Beacon node")
+
+	// The requested validator doesn't exist in the requested state
+	ErrValidatorNotFound = errors.New("the requested validator was not found")
+
+	// The requested slot doesn't have a block, either because it was skipped or because its block was orphaned
+	ErrSlotMissing = errors.New("the requested slot does not have a block")
+
+	// The requested block exists, but isn't part of the canonical chain (it was orphaned by a reorg)
+	ErrBlockNotCanonical = errors.New("the requested block is not canonical")
+
+	// The Beacon node doesn't implement the requested endpoint
+	ErrEndpointNotSupported = errors.New("the Beacon node does not support this endpoint")
+
+	// The Beacon node doesn't track liveness for the requested epoch; most nodes only track the current and previous epochs
+	ErrLivenessEpochUnavailable = errors.New("the Beacon node does not have liveness data for the requested epoch")
+
+	// The requested slot hasn't happened yet, so the node can't produce data for it
+	ErrSlotInFuture = errors.New("the requested slot is in the future")
+
+	// The sync committee for the requested epoch isn't known yet; nodes can only provide the current and next sync committee periods
+	ErrSyncCommitteePeriodUnavailable = errors.New("the sync committee for the requested epoch is not known yet")
+
+	// The Beacon node is rejecting requests because too many have been sent; errors like this are a *RateLimitedError
+	ErrRateLimited = errors.New("the Beacon node is rate limiting requests")
+
+	// The node is syncing, but its sync distance keeps growing instead of shrinking
+	ErrNodeFallingBehind = errors.New("the Beacon node is falling further behind the chain head")
+
+	// The fields in a response don't match the fork named by its consensus version
+	ErrConsensusVersionMismatch = errors.New("the response does not match its consensus version")
+)
+
+// The Beacon node rejected some of the items in a batch submission.
+// Failures maps the position of each rejected item in the batch to the node's reason for rejecting it.
+type BatchSubmissionError struct {
+	Message  string
+	Failures map[int]string
+}
+
+func (e *BatchSubmissionError) Error() string {
+	indices := make([]int, 0, len(e.Failures))
+	for index := range e.Failures {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+
+	lines := make([]string, 0, len(indices)+1)
+	lines = append(lines, fmt.Sprintf("%s (%d items rejected)", e.Message, len(indices)))
+	for _, index := range indices {
+		lines = append(lines, fmt.Sprintf("item %d: %s", index, e.Failures[index]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// The Beacon node responded with 429 Too Many Requests.
+// RetryAfter is how long the node asked the client to wait before trying again, or zero if it didn't say.
+type RateLimitedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	if e.RetryAfter <= 0 {
+		return ErrRateLimited.Error()
+	}
+	return fmt.Sprintf("%s; retry after %s", ErrRateLimited.Error(), e.RetryAfter)
+}
+
+func (e *RateLimitedError) Unwrap() error {
+	return ErrRateLimited
+}
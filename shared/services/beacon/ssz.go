@@ -0,0 +1,35 @@
+package beacon
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Get the SSZ hash tree root of the checkpoint
+func (c Checkpoint) HashTreeRoot() (common.Hash, error) {
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+	c.hashTreeRootWith(hh)
+	return hh.HashRoot()
+}
+
+// Get the SSZ hash tree root of the attestation data, which identifies it in the aggregate attestation route
+func (d AttestationData) HashTreeRoot() (common.Hash, error) {
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+	indx := hh.Index()
+	hh.PutUint64(d.Slot)
+	hh.PutUint64(d.Index)
+	hh.PutBytes(d.BeaconBlockRoot[:])
+	d.Source.hashTreeRootWith(hh)
+	d.Target.hashTreeRootWith(hh)
+	hh.Merkleize(indx)
+	return hh.HashRoot()
+}
+
+func (c Checkpoint) hashTreeRootWith(hh *ssz.Hasher) {
+	indx := hh.Index()
+	hh.PutUint64(c.Epoch)
+	hh.PutBytes(c.Root[:])
+	hh.Merkleize(indx)
+}
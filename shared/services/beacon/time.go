@@ -0,0 +1,48 @@
+package beacon
+
+import (
+	"math"
+	"time"
+)
+
+// The time returned for slots and epochs too far in the future to represent, such as FarFutureEpoch
+var FarFutureTime = time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)
+
+// Get the time at which the provided slot starts
+func (c Eth2Config) SlotToTime(slot uint64) time.Time {
+	farFuture := uint64(FarFutureTime.Unix())
+	if c.GenesisTime >= farFuture || (c.SecondsPerSlot > 0 && slot > (farFuture-c.GenesisTime)/c.SecondsPerSlot) {
+		return FarFutureTime
+	}
+	return time.Unix(int64(c.GenesisTime+slot*c.SecondsPerSlot), 0)
+}
+
+// Get the slot that is in progress at the provided time; times before genesis map to slot 0, as do all times if the config is empty
+func (c Eth2Config) TimeToSlot(t time.Time) uint64 {
+	unix := t.Unix()
+	if unix < int64(c.GenesisTime) || c.SecondsPerSlot == 0 {
+		return 0
+	}
+	return (uint64(unix) - c.GenesisTime) / c.SecondsPerSlot
+}
+
+// Get the time at which the provided epoch starts
+func (c Eth2Config) EpochToTime(epoch uint64) time.Time {
+	if c.SlotsPerEpoch > 0 && epoch > math.MaxUint64/c.SlotsPerEpoch {
+		return FarFutureTime
+	}
+	return c.SlotToTime(c.FirstSlotOf(epoch))
+}
+
+// Get the epoch that contains the provided slot; this is always 0 if the config is empty
+func (c Eth2Config) EpochOf(slot uint64) uint64 {
+	if c.SlotsPerEpoch == 0 {
+		return 0
+	}
+	return slot / c.SlotsPerEpoch
+}
+
+// Get the first slot of the provided epoch
+func (c Eth2Config) FirstSlotOf(epoch uint64) uint64 {
+	return epoch * c.SlotsPerEpoch
+}
@@ -0,0 +1,98 @@
+package beacon
+
+import (
+	"testing"
+	"time"
+)
+
+var mainnetConfig = Eth2Config{
+	GenesisTime:     1606824023,
+	SecondsPerSlot:  12,
+	SlotsPerEpoch:   32,
+	SecondsPerEpoch: 384,
+}
+
+func TestSlotToTime(t *testing.T) {
+	tests := []struct {
+		slot     uint64
+		expected int64
+	}{
+		{slot: 0, expected: 1606824023},
+		{slot: 1, expected: 1606824035},
+		{slot: 31, expected: 1606824395},
+		{slot: 32, expected: 1606824407},
+	}
+	for _, test := range tests {
+		if slotTime := mainnetConfig.SlotToTime(test.slot); slotTime.Unix() != test.expected {
+			t.Errorf("slot %d: expected %d, got %d", test.slot, test.expected, slotTime.Unix())
+		}
+	}
+}
+
+func TestTimeToSlot(t *testing.T) {
+	tests := []struct {
+		unix     int64
+		expected uint64
+	}{
+		{unix: 0, expected: 0},
+		{unix: 1606824022, expected: 0},
+		{unix: 1606824023, expected: 0},
+		{unix: 1606824034, expected: 0},
+		{unix: 1606824035, expected: 1},
+		{unix: 1606824407, expected: 32},
+		{unix: 1710338135, expected: 8626176},
+	}
+	for _, test := range tests {
+		if slot := mainnetConfig.TimeToSlot(time.Unix(test.unix, 0)); slot != test.expected {
+			t.Errorf("time %d: expected slot %d, got %d", test.unix, test.expected, slot)
+		}
+	}
+}
+
+// The mainnet fork activation times
+func TestEpochToTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		epoch    uint64
+		expected time.Time
+	}{
+		{name: "genesis", epoch: 0, expected: time.Date(2020, time.December, 1, 12, 0, 23, 0, time.UTC)},
+		{name: "altair", epoch: 74240, expected: time.Date(2021, time.October, 27, 10, 56, 23, 0, time.UTC)},
+		{name: "bellatrix", epoch: 144896, expected: time.Date(2022, time.September, 6, 11, 34, 47, 0, time.UTC)},
+		{name: "capella", epoch: 194048, expected: time.Date(2023, time.April, 12, 22, 27, 35, 0, time.UTC)},
+		{name: "deneb", epoch: 269568, expected: time.Date(2024, time.March, 13, 13, 55, 35, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		if epochTime := mainnetConfig.EpochToTime(test.epoch); !epochTime.Equal(test.expected) {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expected, epochTime.UTC())
+		}
+		if epoch := mainnetConfig.EpochOf(mainnetConfig.TimeToSlot(test.expected)); epoch != test.epoch {
+			t.Errorf("%s: expected the time to round-trip to epoch %d, got %d", test.name, test.epoch, epoch)
+		}
+	}
+}
+
+func TestFarFutureEpochToTime(t *testing.T) {
+	if epochTime := mainnetConfig.EpochToTime(FarFutureEpoch); !epochTime.Equal(FarFutureTime) {
+		t.Errorf("expected the far future epoch to map to %s, got %s", FarFutureTime, epochTime)
+	}
+	if slotTime := mainnetConfig.SlotToTime(FarFutureEpoch); !slotTime.Equal(FarFutureTime) {
+		t.Errorf("expected the far future slot to map to %s, got %s", FarFutureTime, slotTime)
+	}
+	if epochTime := mainnetConfig.EpochToTime(100000000); !epochTime.Before(FarFutureTime) {
+		t.Errorf("expected epoch 100000000 to be before the far future time, got %s", epochTime)
+	}
+}
+
+func TestEmptyConfig(t *testing.T) {
+	var config Eth2Config
+	if slot := config.TimeToSlot(time.Now()); slot != 0 {
+		t.Errorf("expected slot 0, got %d", slot)
+	}
+	if epoch := config.EpochOf(100); epoch != 0 {
+		t.Errorf("expected epoch 0, got %d", epoch)
+	}
+	if epochTime := config.EpochToTime(FarFutureEpoch); epochTime.Unix() != 0 {
+		t.Errorf("expected the Unix epoch, got %s", epochTime)
+	}
+}
@@ -400,12 +400,12 @@ func checkBeaconClientStatus(bcMgr *BeaconClientManager) (bool, error) {
 
 	// Check the BC status
 	mgrStatus := bcMgr.CheckStatus()
-	if bcMgr.primaryReady {
+	if bcMgr.isReady(0) {
 		return true, nil
 	}
 
 	// If the primary isn't synced but there's a fallback and it is, return true
-	if bcMgr.fallbackReady {
+	if bcMgr.isFallbackReady() {
 		if mgrStatus.PrimaryClientStatus.Error != "" {
 			log.Printf("Primary consensus client is unavailable (%s), using fallback consensus client...\n", mgrStatus.PrimaryClientStatus.Error)
 		} else {
@@ -576,7 +576,7 @@ func waitBeaconClientSynced(c *cli.Context, verbose bool, timeout int64) (bool,
 		}
 
 		// Get sync status
-		syncStatus, err := bcMgr.GetSyncStatus()
+		syncStatus, err := bcMgr.GetSyncStatus(context.Background())
 		if err != nil {
 			return false, err
 		}
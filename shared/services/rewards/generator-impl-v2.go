@@ -587,7 +587,7 @@ func (r *treeGeneratorImpl_v2) calculateEthRewards(checkBeaconPerformance bool)
 	}
 
 	// Get the Beacon config
-	r.beaconConfig, err = r.bc.GetEth2Config()
+	r.beaconConfig, err = r.bc.GetEth2Config(context.Background())
 	if err != nil {
 		return err
 	}
@@ -876,7 +876,7 @@ func (r *treeGeneratorImpl_v2) processEpoch(getDuties bool, epoch uint64) error
 	if getDuties {
 		wg.Go(func() error {
 			var err error
-			committeeData, err = r.bc.GetCommitteesForEpoch(&epoch)
+			committeeData, err = r.bc.GetCommitteesForEpoch(context.Background(), &epoch)
 			return err
 		})
 	}
@@ -885,7 +885,7 @@ func (r *treeGeneratorImpl_v2) processEpoch(getDuties bool, epoch uint64) error
 		i := i
 		slot := epoch*r.slotsPerEpoch + i
 		wg.Go(func() error {
-			attestations, found, err := r.bc.GetAttestations(fmt.Sprint(slot))
+			attestations, found, err := r.bc.GetAttestations(context.Background(), fmt.Sprint(slot))
 			if err != nil {
 				return err
 			}
@@ -1018,7 +1018,7 @@ func (r *treeGeneratorImpl_v2) createMinipoolIndexMap() error {
 
 	// Get indices for all minipool validators
 	r.validatorIndexMap = map[string]*MinipoolInfo{}
-	statusMap, err := r.bc.GetValidatorStatuses(minipoolPubkeys, &beacon.ValidatorStatusOptions{
+	statusMap, err := r.bc.GetValidatorStatuses(context.Background(), minipoolPubkeys, &beacon.ValidatorStatusOptions{
 		Slot: &r.rewardsFile.ConsensusEndBlock,
 	})
 	if err != nil {
@@ -1271,7 +1271,7 @@ func (r *treeGeneratorImpl_v2) getStartBlocksForInterval(previousIntervalEvent r
 	// Get the first block that isn't missing
 	var elBlockNumber uint64
 	for {
-		beaconBlock, exists, err := r.bc.GetBeaconBlock(fmt.Sprint(r.rewardsFile.ConsensusStartBlock))
+		beaconBlock, exists, err := r.bc.GetBeaconBlock(context.Background(), fmt.Sprint(r.rewardsFile.ConsensusStartBlock))
 		if err != nil {
 			return nil, fmt.Errorf("error getting EL data for BC slot %d: %w", r.rewardsFile.ConsensusStartBlock, err)
 		}
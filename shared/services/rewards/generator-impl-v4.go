@@ -125,7 +125,7 @@ func (r *treeGeneratorImpl_v4) generateTree(rp *rocketpool.RocketPool, cfg *conf
 
 	// Get the Beacon config
 	var err error
-	r.beaconConfig, err = r.bc.GetEth2Config()
+	r.beaconConfig, err = r.bc.GetEth2Config(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +205,7 @@ func (r *treeGeneratorImpl_v4) approximateStakerShareOfSmoothingPool(rp *rocketp
 
 	// Get the Beacon config
 	var err error
-	r.beaconConfig, err = r.bc.GetEth2Config()
+	r.beaconConfig, err = r.bc.GetEth2Config(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -929,7 +929,7 @@ func (r *treeGeneratorImpl_v4) processEpoch(getDuties bool, epoch uint64) error
 	if getDuties {
 		wg.Go(func() error {
 			var err error
-			committeeData, err = r.bc.GetCommitteesForEpoch(&epoch)
+			committeeData, err = r.bc.GetCommitteesForEpoch(context.Background(), &epoch)
 			return err
 		})
 	}
@@ -938,7 +938,7 @@ func (r *treeGeneratorImpl_v4) processEpoch(getDuties bool, epoch uint64) error
 		i := i
 		slot := epoch*r.slotsPerEpoch + i
 		wg.Go(func() error {
-			attestations, found, err := r.bc.GetAttestations(fmt.Sprint(slot))
+			attestations, found, err := r.bc.GetAttestations(context.Background(), fmt.Sprint(slot))
 			if err != nil {
 				return err
 			}
@@ -1074,7 +1074,7 @@ func (r *treeGeneratorImpl_v4) createMinipoolIndexMap() error {
 
 	// Get the status for all uncached minipool validators and add them to the cache
 	r.validatorIndexMap = map[string]*MinipoolInfo{}
-	statusMap, err := r.bc.GetValidatorStatuses(uncachedMinipoolPubkeys, &beacon.ValidatorStatusOptions{
+	statusMap, err := r.bc.GetValidatorStatuses(context.Background(), uncachedMinipoolPubkeys, &beacon.ValidatorStatusOptions{
 		Slot: &r.rewardsFile.ConsensusEndBlock,
 	})
 	for pubkey, status := range r.validatorStatusMap {
@@ -1326,7 +1326,7 @@ func (r *treeGeneratorImpl_v4) validateNetwork(network uint64) (bool, error) {
 // Gets the start blocks for the given interval
 func (r *treeGeneratorImpl_v4) getStartBlocksForInterval(previousIntervalEvent rewards.RewardsEvent) (*types.Header, error) {
 	// Sanity check to confirm the BN can access the block from the previous interval
-	_, exists, err := r.bc.GetBeaconBlock(previousIntervalEvent.ConsensusBlock.String())
+	_, exists, err := r.bc.GetBeaconBlock(context.Background(), previousIntervalEvent.ConsensusBlock.String())
 	if err != nil {
 		return nil, fmt.Errorf("error verifying block from previous interval: %w", err)
 	}
@@ -1342,7 +1342,7 @@ func (r *treeGeneratorImpl_v4) getStartBlocksForInterval(previousIntervalEvent r
 	// Get the first block that isn't missing
 	var elBlockNumber uint64
 	for {
-		beaconBlock, exists, err := r.bc.GetBeaconBlock(fmt.Sprint(r.rewardsFile.ConsensusStartBlock))
+		beaconBlock, exists, err := r.bc.GetBeaconBlock(context.Background(), fmt.Sprint(r.rewardsFile.ConsensusStartBlock))
 		if err != nil {
 			return nil, fmt.Errorf("error getting EL data for BC slot %d: %w", r.rewardsFile.ConsensusStartBlock, err)
 		}
@@ -1471,7 +1471,7 @@ func (r *treeGeneratorImpl_v4) getNodeEffectiveRPLStakes() ([]*big.Int, error) {
 	// Get the status for all staking minipool validators
 	r.log.Printlnf("%s Getting validator statuses for all eligible minipools", r.logPrefix)
 	r.validatorIndexMap = map[string]*MinipoolInfo{}
-	statusMap, err := r.bc.GetValidatorStatuses(r.stakingMinipoolPubkeys, &beacon.ValidatorStatusOptions{
+	statusMap, err := r.bc.GetValidatorStatuses(context.Background(), r.stakingMinipoolPubkeys, &beacon.ValidatorStatusOptions{
 		Slot: &r.rewardsFile.ConsensusEndBlock,
 	})
 	if err != nil {
@@ -782,7 +782,7 @@ func (r *treeGeneratorImpl_v5) processEpoch(getDuties bool, epoch uint64) error
 	if getDuties {
 		wg.Go(func() error {
 			var err error
-			committeeData, err = r.bc.GetCommitteesForEpoch(&epoch)
+			committeeData, err = r.bc.GetCommitteesForEpoch(context.Background(), &epoch)
 			return err
 		})
 	}
@@ -791,7 +791,7 @@ func (r *treeGeneratorImpl_v5) processEpoch(getDuties bool, epoch uint64) error
 		i := i
 		slot := epoch*r.slotsPerEpoch + i
 		wg.Go(func() error {
-			attestations, found, err := r.bc.GetAttestations(fmt.Sprint(slot))
+			attestations, found, err := r.bc.GetAttestations(context.Background(), fmt.Sprint(slot))
 			if err != nil {
 				return err
 			}
@@ -1104,7 +1104,7 @@ func (r *treeGeneratorImpl_v5) validateNetwork(network uint64) (bool, error) {
 // Gets the start blocks for the given interval
 func (r *treeGeneratorImpl_v5) getStartBlocksForInterval(previousIntervalEvent rewards.RewardsEvent) (*types.Header, error) {
 	// Sanity check to confirm the BN can access the block from the previous interval
-	_, exists, err := r.bc.GetBeaconBlock(previousIntervalEvent.ConsensusBlock.String())
+	_, exists, err := r.bc.GetBeaconBlock(context.Background(), previousIntervalEvent.ConsensusBlock.String())
 	if err != nil {
 		return nil, fmt.Errorf("error verifying block from previous interval: %w", err)
 	}
@@ -1120,7 +1120,7 @@ func (r *treeGeneratorImpl_v5) getStartBlocksForInterval(previousIntervalEvent r
 	// Get the first block that isn't missing
 	var elBlockNumber uint64
 	for {
-		beaconBlock, exists, err := r.bc.GetBeaconBlock(fmt.Sprint(r.rewardsFile.ConsensusStartBlock))
+		beaconBlock, exists, err := r.bc.GetBeaconBlock(context.Background(), fmt.Sprint(r.rewardsFile.ConsensusStartBlock))
 		if err != nil {
 			return nil, fmt.Errorf("error getting EL data for BC slot %d: %w", r.rewardsFile.ConsensusStartBlock, err)
 		}
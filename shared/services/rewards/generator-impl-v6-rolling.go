@@ -675,7 +675,7 @@ func (r *treeGeneratorImpl_v6_rolling) getStartBlocksForInterval() (*types.Heade
 	// Get the Beacon block for the start slot of the record
 	r.rewardsFile.ConsensusStartBlock = r.rollingRecord.StartSlot
 	r.rewardsFile.MinipoolPerformanceFile.ConsensusStartBlock = r.rollingRecord.StartSlot
-	beaconBlock, exists, err := r.bc.GetBeaconBlock(fmt.Sprint(r.rollingRecord.StartSlot))
+	beaconBlock, exists, err := r.bc.GetBeaconBlock(context.Background(), fmt.Sprint(r.rollingRecord.StartSlot))
 	if err != nil {
 		return nil, fmt.Errorf("error verifying block from previous interval: %w", err)
 	}
@@ -1,6 +1,7 @@
 package rewards
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -214,7 +215,7 @@ func (r *RollingRecord) getDutiesForEpoch(epoch uint64, endSlot uint64, state *s
 	}
 
 	// Get the attestation committees for the epoch
-	committees, err := r.bc.GetCommitteesForEpoch(&epoch)
+	committees, err := r.bc.GetCommitteesForEpoch(context.Background(), &epoch)
 	if err != nil {
 		return fmt.Errorf("error getting committees for epoch %d: %w", epoch, err)
 	}
@@ -304,7 +305,7 @@ func (r *RollingRecord) processAttestationsInEpoch(epoch uint64, state *state.Ne
 		i := i
 		slot := epoch*slotsPerEpoch + i
 		wg.Go(func() error {
-			attestations, found, err := r.bc.GetAttestations(fmt.Sprint(slot))
+			attestations, found, err := r.bc.GetAttestations(context.Background(), fmt.Sprint(slot))
 			if err != nil {
 				return fmt.Errorf("error getting attestations for slot %d: %w", slot, err)
 			}
@@ -377,13 +377,13 @@ func decompressFile(compressedBytes []byte) ([]byte, error) {
 // Gets the start slot for the given interval
 func GetStartSlotForInterval(previousIntervalEvent rewards.RewardsEvent, bc beacon.Client, beaconConfig beacon.Eth2Config) (uint64, error) {
 	// Get the chain head
-	head, err := bc.GetBeaconHead()
+	head, err := bc.GetBeaconHead(context.Background())
 	if err != nil {
 		return 0, fmt.Errorf("error getting Beacon chain head: %w", err)
 	}
 
 	// Sanity check to confirm the BN can access the block from the previous interval
-	_, exists, err := bc.GetBeaconBlock(previousIntervalEvent.ConsensusBlock.String())
+	_, exists, err := bc.GetBeaconBlock(context.Background(), previousIntervalEvent.ConsensusBlock.String())
 	if err != nil {
 		return 0, fmt.Errorf("error verifying block from previous interval: %w", err)
 	}
@@ -399,7 +399,7 @@ func GetStartSlotForInterval(previousIntervalEvent rewards.RewardsEvent, bc beac
 	currentEpoch := consensusStartBlock / beaconConfig.SlotsPerEpoch
 	found := false
 	for currentEpoch <= head.Epoch {
-		_, exists, err := bc.GetBeaconBlock(fmt.Sprint(consensusStartBlock))
+		_, exists, err := bc.GetBeaconBlock(context.Background(), fmt.Sprint(consensusStartBlock))
 		if err != nil {
 			return 0, fmt.Errorf("error getting EL data for BC slot %d: %w", consensusStartBlock, err)
 		}
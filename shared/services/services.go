@@ -274,7 +274,7 @@ func getBeaconClient(c *cli.Context, cfg *config.RocketPoolConfig) (*BeaconClien
 				bcManager.ignoreSyncCheck = true
 			}
 			if c.GlobalBool("force-fallbacks") {
-				bcManager.primaryReady = false
+				bcManager.forceFallbacks()
 			}
 		}
 	})
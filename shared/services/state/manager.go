@@ -43,7 +43,7 @@ func NewNetworkStateManager(rp *rocketpool.RocketPool, cfg *config.RocketPoolCon
 
 	// Get the Beacon config info
 	var err error
-	m.BeaconConfig, err = m.bc.GetEth2Config()
+	m.BeaconConfig, err = m.bc.GetEth2Config(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +86,7 @@ func (m *NetworkStateManager) GetLatestBeaconBlock() (beacon.BeaconBlock, error)
 
 // Gets the latest valid finalized block
 func (m *NetworkStateManager) GetLatestFinalizedBeaconBlock() (beacon.BeaconBlock, error) {
-	head, err := m.bc.GetBeaconHead()
+	head, err := m.bc.GetBeaconHead(context.Background())
 	if err != nil {
 		return beacon.BeaconBlock{}, fmt.Errorf("error getting Beacon chain head: %w", err)
 	}
@@ -114,7 +114,7 @@ func (m *NetworkStateManager) GetHeadSlot() (uint64, error) {
 func (m *NetworkStateManager) GetLatestProposedBeaconBlock(targetSlot uint64) (beacon.BeaconBlock, error) {
 	for {
 		// Try to get the current block
-		block, exists, err := m.bc.GetBeaconBlock(fmt.Sprint(targetSlot))
+		block, exists, err := m.bc.GetBeaconBlock(context.Background(), fmt.Sprint(targetSlot))
 		if err != nil {
 			return beacon.BeaconBlock{}, fmt.Errorf("error getting Beacon block %d: %w", targetSlot, err)
 		}
@@ -1,6 +1,7 @@
 package rp
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -42,7 +43,7 @@ func GetFeeRecipientInfo(rp *rocketpool.RocketPool, bc beacon.Client, nodeAddres
 
 		// Get the Beacon info
 		beaconConfig := state.BeaconConfig
-		beaconHead, err := bc.GetBeaconHead()
+		beaconHead, err := bc.GetBeaconHead(context.Background())
 		if err != nil {
 			return nil, fmt.Errorf("Error getting Beacon head: %w", err)
 		}
@@ -120,11 +121,11 @@ func GetFeeRecipientInfoWithoutState(rp *rocketpool.RocketPool, bc beacon.Client
 		}
 
 		// Get the Beacon info
-		beaconConfig, err := bc.GetEth2Config()
+		beaconConfig, err := bc.GetEth2Config(context.Background())
 		if err != nil {
 			return nil, fmt.Errorf("Error getting Beacon config: %w", err)
 		}
-		beaconHead, err := bc.GetBeaconHead()
+		beaconHead, err := bc.GetBeaconHead(context.Background())
 		if err != nil {
 			return nil, fmt.Errorf("Error getting Beacon head: %w", err)
 		}
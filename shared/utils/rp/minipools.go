@@ -2,6 +2,7 @@ package rp
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -69,7 +70,7 @@ func GetMinipoolValidators(rp *rocketpool.RocketPool, bc beacon.Client, addresse
 	}
 
 	// Get validator statuses
-	statuses, err := bc.GetValidatorStatuses(filteredPubkeys, validatorStatusOpts)
+	statuses, err := bc.GetValidatorStatuses(context.Background(), filteredPubkeys, validatorStatusOpts)
 	if err != nil {
 		return map[common.Address]beacon.ValidatorStatus{}, err
 	}
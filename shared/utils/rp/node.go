@@ -46,7 +46,7 @@ func GetNodeValidatorIndices(rp *rocketpool.RocketPool, ec rocketpool.ExecutionC
 	pubkeys = filteredPubkeys
 
 	// Get validator statuses by pubkeys
-	statuses, err := bc.GetValidatorStatuses(pubkeys, nil)
+	statuses, err := bc.GetValidatorStatuses(context.Background(), pubkeys, nil)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting validator statuses: %w", err)
 	}